@@ -0,0 +1,17 @@
+// Command errorsvet runs the analyzers of this module. It can be run directly
+// or used as a vet tool.
+//
+//	go install github.com/kapetan-io/errors/analysis/cmd/errorsvet@latest
+//	errorsvet ./...
+//	go vet -vettool=$(which errorsvet) ./...
+package main
+
+import (
+	"github.com/kapetan-io/errors/analysis/handleonce"
+	"github.com/kapetan-io/errors/analysis/withargs"
+	"golang.org/x/tools/go/analysis/multichecker"
+)
+
+func main() {
+	multichecker.Main(withargs.Analyzer, handleonce.Analyzer)
+}
@@ -0,0 +1,382 @@
+// Package handleonce defines an Analyzer which reports errors which are not handled
+// exactly once, see https://dave.cheney.net/2016/04/27/dont-just-check-errors-handle-them-gracefully
+//
+// The analyzer reports
+//
+//   - errors which are logged with log/slog and then returned or wrapped and returned,
+//     such that the error is logged again by the caller
+//   - exported functions which return an error created by the errors package in another
+//     package without wrapping it, such that the error does not record where it crossed
+//     the package boundary. Use errors.Wrap() or errors.Errorf() to wrap the error.
+package handleonce
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// ErrorsPath is the import path of the errors package
+const ErrorsPath = "github.com/kapetan-io/errors"
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "handleonce",
+	Doc:       "report errors which are both logged and returned, or returned across a package boundary without wrapping",
+	URL:       "https://pkg.go.dev/github.com/kapetan-io/errors/analysis/handleonce",
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	Run:       run,
+	FactTypes: []analysis.Fact{new(returnsErrAttrs)},
+}
+
+// returnsErrAttrs is exported for functions which return errors created by the errors package
+type returnsErrAttrs struct{}
+
+func (*returnsErrAttrs) AFact() {}
+
+func (*returnsErrAttrs) String() string { return "returnsErrAttrs" }
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// constructors are the package level functions of the errors package which create errors with a code location
+var constructors = map[string]bool{
+	"Error":        true,
+	"Errorf":       true,
+	"Wrap":         true,
+	"Wrapf":        true,
+	"WithStack":    true,
+	"WithMessage":  true,
+	"WithMessagef": true,
+}
+
+// methods are the methods of *errors.Attrs which create errors with a code location. The package
+// level errors.Join() is not included as it is the standard library Join.
+var methods = map[string]bool{
+	"Error":  true,
+	"Errorf": true,
+	"Wrap":   true,
+	"Wrapf":  true,
+	"Join":   true,
+}
+
+var logFuncs = map[string]bool{
+	"Debug":        true,
+	"DebugContext": true,
+	"Info":         true,
+	"InfoContext":  true,
+	"Warn":         true,
+	"WarnContext":  true,
+	"Error":        true,
+	"ErrorContext": true,
+	"Log":          true,
+	"LogAttrs":     true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	in := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	var funcs []*ast.FuncDecl
+	in.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		if fd := n.(*ast.FuncDecl); fd.Body != nil {
+			funcs = append(funcs, fd)
+		}
+	})
+
+	exportFacts(pass, funcs)
+
+	in.Preorder([]ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}, func(n ast.Node) {
+		switch x := n.(type) {
+		case *ast.FuncDecl:
+			if x.Body != nil {
+				checkLogAndReturn(pass, x.Body)
+			}
+		case *ast.FuncLit:
+			checkLogAndReturn(pass, x.Body)
+		}
+	})
+
+	for _, fd := range funcs {
+		if isBoundary(pass, fd) {
+			checkBoundary(pass, fd)
+		}
+	}
+	return nil, nil
+}
+
+// exportFacts exports the returnsErrAttrs fact for each function which returns an error
+// created by the errors package, repeating until no new facts are found such that
+// functions which return the result of other functions in this package are included.
+func exportFacts(pass *analysis.Pass, funcs []*ast.FuncDecl) {
+	for changed := true; changed; {
+		changed = false
+		for _, fd := range funcs {
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok || pass.ImportObjectFact(fn, new(returnsErrAttrs)) {
+				continue
+			}
+			if returnsCreated(pass, fd) {
+				pass.ExportObjectFact(fn, new(returnsErrAttrs))
+				changed = true
+			}
+		}
+	}
+}
+
+// returnsCreated returns true if any return statement of fd returns an error created
+// by the errors package or a function with the returnsErrAttrs fact.
+func returnsCreated(pass *analysis.Pass, fd *ast.FuncDecl) bool {
+	assigned := assignments(pass, fd.Body)
+	var found bool
+	inspectReturns(fd.Body, func(ret *ast.ReturnStmt) {
+		for _, r := range ret.Results {
+			if found {
+				return
+			}
+			if call, ok := ast.Unparen(r).(*ast.CallExpr); ok {
+				found = creates(pass, callee(pass, call))
+				continue
+			}
+			if v := varOf(pass, r); v != nil {
+				for _, fn := range assigned[v] {
+					if creates(pass, fn) {
+						found = true
+					}
+				}
+			}
+		}
+	})
+	return found
+}
+
+// creates returns true if fn is a constructor of the errors package or has the returnsErrAttrs fact
+func creates(pass *analysis.Pass, fn *types.Func) bool {
+	if fn == nil || fn.Pkg() == nil {
+		return false
+	}
+	if fn.Pkg().Path() == ErrorsPath {
+		if fn.Type().(*types.Signature).Recv() != nil {
+			return methods[fn.Name()]
+		}
+		return constructors[fn.Name()]
+	}
+	return pass.ImportObjectFact(fn, new(returnsErrAttrs))
+}
+
+// isBoundary returns true if fd is an exported function or method of an exported type
+func isBoundary(pass *analysis.Pass, fd *ast.FuncDecl) bool {
+	if !fd.Name.IsExported() || pass.Pkg.Path() == ErrorsPath {
+		return false
+	}
+	if fd.Recv == nil || len(fd.Recv.List) == 0 {
+		return true
+	}
+	t := pass.TypesInfo.TypeOf(fd.Recv.List[0].Type)
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Exported()
+}
+
+// checkBoundary reports errors created in another package which fd returns without wrapping
+func checkBoundary(pass *analysis.Pass, fd *ast.FuncDecl) {
+	assigned := assignments(pass, fd.Body)
+	inspectReturns(fd.Body, func(ret *ast.ReturnStmt) {
+		for _, r := range ret.Results {
+			if call, ok := ast.Unparen(r).(*ast.CallExpr); ok {
+				if fn := callee(pass, call); external(pass, fn) {
+					reportBoundary(pass, r, fn)
+				}
+				continue
+			}
+			v := varOf(pass, r)
+			if v == nil || len(assigned[v]) == 0 {
+				continue
+			}
+			// Only report when every assignment is an unwrapped error from another package
+			var fn *types.Func
+			for _, a := range assigned[v] {
+				if !external(pass, a) {
+					fn = nil
+					break
+				}
+				fn = a
+			}
+			if fn != nil {
+				reportBoundary(pass, r, fn)
+			}
+		}
+	})
+}
+
+func reportBoundary(pass *analysis.Pass, r ast.Expr, fn *types.Func) {
+	pass.ReportRangef(r, "error from %s.%s is returned from package %s without wrapping; "+
+		"use errors.Wrap() to record where it crossed the package boundary", fn.Pkg().Name(), fn.Name(), pass.Pkg.Name())
+}
+
+// external returns true if fn is in another package and returns errors created by the errors package
+func external(pass *analysis.Pass, fn *types.Func) bool {
+	if fn == nil || fn.Pkg() == nil || fn.Pkg() == pass.Pkg || fn.Pkg().Path() == ErrorsPath {
+		return false
+	}
+	return pass.ImportObjectFact(fn, new(returnsErrAttrs))
+}
+
+// checkLogAndReturn reports errors which are logged and then returned within the same block
+func checkLogAndReturn(pass *analysis.Pass, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.FuncLit:
+			// Checked separately
+			return false
+		case *ast.BlockStmt:
+			checkBlock(pass, x.List)
+		case *ast.CaseClause:
+			checkBlock(pass, x.Body)
+		case *ast.CommClause:
+			checkBlock(pass, x.Body)
+		}
+		return true
+	})
+}
+
+func checkBlock(pass *analysis.Pass, stmts []ast.Stmt) {
+	for i, stmt := range stmts {
+		es, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		call, ok := es.X.(*ast.CallExpr)
+		if !ok || !isLog(callee(pass, call)) {
+			continue
+		}
+		for _, v := range errorVars(pass, call.Args) {
+			if returned(pass, stmts[i+1:], v) {
+				pass.ReportRangef(call, "error '%s' is logged and returned; handle errors once by either logging or returning them", v.Name())
+				break
+			}
+		}
+	}
+}
+
+// returned returns true if any return statement in stmts returns v or a wrap of v
+func returned(pass *analysis.Pass, stmts []ast.Stmt, v *types.Var) bool {
+	var found bool
+	for _, s := range stmts {
+		inspectReturns(s, func(ret *ast.ReturnStmt) {
+			for _, r := range ret.Results {
+				if !found && refers(pass, r, v) {
+					found = true
+				}
+			}
+		})
+	}
+	return found
+}
+
+// errorVars returns the error variables referenced by the expressions, including those
+// passed to other functions such as `slog.Any("error", err)` or `errors.AttrsFrom(err)`
+func errorVars(pass *analysis.Pass, exprs []ast.Expr) []*types.Var {
+	var result []*types.Var
+	seen := make(map[*types.Var]bool)
+	for _, e := range exprs {
+		ast.Inspect(e, func(n ast.Node) bool {
+			id, ok := n.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			v, ok := pass.TypesInfo.Uses[id].(*types.Var)
+			if ok && !seen[v] && !v.IsField() && types.Implements(v.Type(), errorType) {
+				seen[v] = true
+				result = append(result, v)
+			}
+			return true
+		})
+	}
+	return result
+}
+
+// refers returns true if the expression references v
+func refers(pass *analysis.Pass, e ast.Expr, v *types.Var) bool {
+	var found bool
+	ast.Inspect(e, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && pass.TypesInfo.Uses[id] == v {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// assignments returns the functions whose error result is assigned to each variable
+func assignments(pass *analysis.Pass, body *ast.BlockStmt) map[*types.Var][]*types.Func {
+	result := make(map[*types.Var][]*types.Func)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.AssignStmt:
+			if len(x.Rhs) == 1 && len(x.Lhs) > 1 {
+				// v, err := fn()
+				if call, ok := ast.Unparen(x.Rhs[0]).(*ast.CallExpr); ok {
+					for _, l := range x.Lhs {
+						if v := varOf(pass, l); v != nil && types.Implements(v.Type(), errorType) {
+							result[v] = append(result[v], callee(pass, call))
+						}
+					}
+				}
+				return true
+			}
+			for i, l := range x.Lhs {
+				v := varOf(pass, l)
+				if v == nil || i >= len(x.Rhs) || !types.Implements(v.Type(), errorType) {
+					continue
+				}
+				var fn *types.Func
+				if call, ok := ast.Unparen(x.Rhs[i]).(*ast.CallExpr); ok {
+					fn = callee(pass, call)
+				}
+				result[v] = append(result[v], fn)
+			}
+		}
+		return true
+	})
+	return result
+}
+
+// inspectReturns calls fn for each return statement in n, excluding those of function literals
+func inspectReturns(n ast.Node, fn func(*ast.ReturnStmt)) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			fn(x)
+		}
+		return true
+	})
+}
+
+func isLog(fn *types.Func) bool {
+	return fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == "log/slog" && logFuncs[fn.Name()]
+}
+
+func callee(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fn, _ := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	return fn
+}
+
+func varOf(pass *analysis.Pass, e ast.Expr) *types.Var {
+	id, ok := ast.Unparen(e).(*ast.Ident)
+	if !ok || id.Name == "_" {
+		return nil
+	}
+	obj := pass.TypesInfo.ObjectOf(id)
+	if v, ok := obj.(*types.Var); ok && v.Pkg() != nil && v.Parent() != v.Pkg().Scope() {
+		return v
+	}
+	return nil
+}
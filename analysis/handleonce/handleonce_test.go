@@ -0,0 +1,12 @@
+package handleonce_test
+
+import (
+	"testing"
+
+	"github.com/kapetan-io/errors/analysis/handleonce"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), handleonce.Analyzer, "lib", "a")
+}
@@ -0,0 +1,74 @@
+package a
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kapetan-io/errors"
+	"lib"
+)
+
+func LogAndReturn(ctx context.Context, log *slog.Logger) error { // want LogAndReturn:"returnsErrAttrs"
+	if err := lib.Put("id"); err != nil {
+		slog.Error("put failed", "error", err) // want `error 'err' is logged and returned; handle errors once by either logging or returning them`
+		return err                             // want `error from lib.Put is returned from package a without wrapping`
+	}
+	if _, err := lib.Get("id"); err != nil {
+		log.ErrorContext(ctx, "get failed", errors.AttrsFrom(err)...) // want `error 'err' is logged and returned`
+		return errors.Errorf("while getting: %w", err)
+	}
+	if err := lib.Std(); err != nil {
+		slog.LogAttrs(ctx, slog.LevelError, "std failed", slog.Any("error", err)) // want `error 'err' is logged and returned`
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+func LogOnly() {
+	if err := lib.Put("id"); err != nil {
+		slog.Error("put failed", "error", err)
+		return
+	}
+	func() error {
+		err := lib.Put("id")
+		slog.Warn("put failed", "error", err) // want `error 'err' is logged and returned`
+		return err
+	}()
+}
+
+func Unwrapped() error { // want Unwrapped:"returnsErrAttrs"
+	_, err := lib.Get("id")
+	if err != nil {
+		return err // want `error from lib.Get is returned from package a without wrapping; use errors.Wrap\(\) to record where it crossed the package boundary`
+	}
+	return lib.Put("id") // want `error from lib.Put is returned from package a without wrapping`
+}
+
+type Client struct{}
+
+func (c *Client) Get(id string) (string, error) { // want Get:"returnsErrAttrs"
+	v, err := lib.Get(id)
+	return v, err // want `error from lib.Get is returned from package a without wrapping`
+}
+
+func Wrapped() error { // want Wrapped:"returnsErrAttrs"
+	err := lib.Put("id")
+	if err != nil {
+		err = errors.Wrap(err)
+		return err
+	}
+	if err := lib.Std(); err != nil {
+		return err
+	}
+	return errors.Errorf("while putting: %w", lib.Put("id"))
+}
+
+func unexported() error { // want unexported:"returnsErrAttrs"
+	return lib.Put("id")
+}
+
+type client struct{}
+
+func (c *client) Get(id string) (string, error) { // want Get:"returnsErrAttrs"
+	return lib.Get(id)
+}
@@ -0,0 +1,36 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+)
+
+const (
+	OtelUserID     = "user.id"
+	OtelServerPort = "server.port"
+)
+
+type Attrs struct{}
+
+func With(args ...any) *Attrs { return &Attrs{} }
+
+func ContextWith(ctx context.Context, args ...any) context.Context { return ctx }
+
+func (a *Attrs) With(args ...any) *Attrs { return a }
+
+func (a *Attrs) WithStack() *Attrs { return a }
+
+func (a *Attrs) Error(msg string) error { return fmt.Errorf("%s", msg) }
+
+func (a *Attrs) Join(errs ...error) error { return stderrors.Join(errs...) }
+
+func Errorf(format string, args ...any) error { return fmt.Errorf(format, args...) }
+
+func Error(msg string) error { return fmt.Errorf("%s", msg) }
+
+func Wrap(err error) error { return err }
+
+func AttrsFrom(err error) []any { return nil }
+
+func Join(errs ...error) error { return stderrors.Join(errs...) }
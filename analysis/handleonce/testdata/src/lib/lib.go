@@ -0,0 +1,35 @@
+package lib
+
+import (
+	"fmt"
+
+	"github.com/kapetan-io/errors"
+)
+
+func Get(id string) (string, error) { // want Get:"returnsErrAttrs"
+	if id == "" {
+		return "", errors.Error("id is required")
+	}
+	return id, nil
+}
+
+func Put(id string) error { // want Put:"returnsErrAttrs"
+	_, err := Get(id)
+	return err
+}
+
+func Std() error {
+	return fmt.Errorf("std")
+}
+
+func Join() error { // want Join:"returnsErrAttrs"
+	return errors.With().Join(Std())
+}
+
+func StdJoin() error {
+	return errors.Join(Std())
+}
+
+func Attrs(id string) *errors.Attrs {
+	return errors.With(errors.OtelUserID, id).WithStack()
+}
@@ -0,0 +1,34 @@
+package a
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/kapetan-io/errors"
+)
+
+type Key string
+
+const key = "key"
+
+func with(ctx context.Context, name string, args []any) {
+	_ = errors.With("foo", "bar", slog.String("baz", "qux"), key, 1)
+	_ = errors.With("foo", "bar", "baz") // want `odd number of arguments; key "baz" has no value and produces a !BADKEY attribute`
+	_ = errors.With(1, "bar")            // want `key of type int is not a string and produces a !BADKEY attribute`
+	_ = errors.With(Key("foo"), "bar")   // want `key of type a.Key is not a string and produces a !BADKEY attribute`
+	_ = errors.With(name, "bar")         // want `key should be a constant string`
+	_ = errors.With("user.id", "u-1")    // want `use the constant errors.OtelUserID instead of the string literal "user.id"`
+	_ = errors.With(errors.OtelUserID, "u-1")
+	_ = errors.With(args...)
+
+	_ = errors.With("foo", "bar").With("baz") // want `odd number of arguments; key "baz" has no value`
+	_ = errors.ContextWith(ctx, "server.port", 80) // want `use the constant errors.OtelServerPort instead of the string literal "server.port"`
+	_ = errors.ContextWith(ctx, "foo")             // want `odd number of arguments; key "foo" has no value`
+}
+
+func errorf(err error) error {
+	_ = fmt.Errorf("no wrap %s", "foo")
+	return fmt.Errorf("while reading: %w", io.EOF) // want `use errors.Errorf\(\) instead of fmt.Errorf\(\) to include the code location`
+}
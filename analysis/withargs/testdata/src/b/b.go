@@ -0,0 +1,11 @@
+// Package b does not import the errors package
+package b
+
+import (
+	"fmt"
+	"io"
+)
+
+func errorf() error {
+	return fmt.Errorf("while reading: %w", io.EOF)
+}
@@ -0,0 +1,23 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	OtelUserID     = "user.id"
+	OtelServerPort = "server.port"
+)
+
+type Attrs struct{}
+
+func With(args ...any) *Attrs { return &Attrs{} }
+
+func ContextWith(ctx context.Context, args ...any) context.Context { return ctx }
+
+func (a *Attrs) With(args ...any) *Attrs { return a }
+
+func (a *Attrs) Error(msg string) error { return fmt.Errorf("%s", msg) }
+
+func Errorf(format string, args ...any) error { return fmt.Errorf(format, args...) }
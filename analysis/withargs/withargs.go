@@ -0,0 +1,247 @@
+// Package withargs defines an Analyzer which reports misuse of the key value
+// arguments passed to errors.With(), errors.ContextWith() and Attrs.With()
+//
+// Arguments are interpreted the same way as slog, a string key followed by a value or
+// an slog.Attr. An odd number of arguments or a key which is not a string produces an
+// attribute with the key '!BADKEY' which is only noticed once the error is logged.
+// The analyzer reports
+//
+//   - an odd number of arguments, where the last key has no value
+//   - keys which are not of type string
+//   - keys which are not constant
+//   - string literal keys which duplicate an OTEL constant such as errors.OtelUserID
+//   - fmt.Errorf() with %w in packages which import the errors package, where
+//     errors.Errorf() would include the code location
+package withargs
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// ErrorsPath is the import path of the errors package
+const ErrorsPath = "github.com/kapetan-io/errors"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "withargs",
+	Doc:      "report misuse of the key value arguments passed to errors.With()",
+	URL:      "https://pkg.go.dev/github.com/kapetan-io/errors/analysis/withargs",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	errs := errorsPackage(pass.Pkg)
+	if errs == nil {
+		return nil, nil
+	}
+	otel := otelConstants(errs)
+
+	in := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	in.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		call := n.(*ast.CallExpr)
+		fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		if !ok || fn.Pkg() == nil {
+			return true
+		}
+
+		switch {
+		// The errors package uses fmt.Errorf() to implement errors.Errorf()
+		case fn.Pkg().Path() == "fmt" && fn.Name() == "Errorf" && pass.Pkg.Path() != ErrorsPath:
+			checkErrorf(pass, call, file(stack))
+		case fn.Pkg().Path() == ErrorsPath && isWith(fn):
+			args := call.Args
+			if fn.Name() == "ContextWith" && len(args) > 0 {
+				args = args[1:]
+			}
+			// Arguments passed with `args...` can not be checked
+			if call.Ellipsis.IsValid() {
+				return true
+			}
+			checkArgs(pass, args, otel, file(stack))
+		}
+		return true
+	})
+	return nil, nil
+}
+
+// isWith returns true if fn is errors.With(), errors.ContextWith() or (*Attrs).With()
+func isWith(fn *types.Func) bool {
+	sig := fn.Type().(*types.Signature)
+	switch fn.Name() {
+	case "With", "ContextWith":
+		if sig.Recv() == nil {
+			return true
+		}
+		ptr, ok := sig.Recv().Type().(*types.Pointer)
+		if !ok {
+			return false
+		}
+		named, ok := ptr.Elem().(*types.Named)
+		return ok && named.Obj().Name() == "Attrs"
+	}
+	return false
+}
+
+func checkArgs(pass *analysis.Pass, args []ast.Expr, otel map[string]string, f *ast.File) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		t := pass.TypesInfo.TypeOf(arg)
+		if t == nil {
+			continue
+		}
+		if isAttr(t) {
+			continue
+		}
+
+		b, ok := t.(*types.Basic)
+		if !ok || b.Info()&types.IsString == 0 {
+			pass.ReportRangef(arg, "key of type %s is not a string and produces a !BADKEY attribute", t)
+			// Assume the next argument is the value of the key to avoid reporting it as a key
+			i++
+			continue
+		}
+
+		tv := pass.TypesInfo.Types[arg]
+		if tv.Value == nil {
+			pass.ReportRangef(arg, "key should be a constant string")
+		} else if name, ok := otel[constant.StringVal(tv.Value)]; ok {
+			if lit, ok := arg.(*ast.BasicLit); ok {
+				reportOtel(pass, lit, name, f)
+			}
+		}
+
+		if i == len(args)-1 {
+			pass.ReportRangef(arg, "odd number of arguments; key %s has no value and produces a !BADKEY attribute", render(pass, arg))
+		}
+		i++
+	}
+}
+
+func reportOtel(pass *analysis.Pass, lit *ast.BasicLit, name string, f *ast.File) {
+	d := analysis.Diagnostic{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		Message: fmt.Sprintf("use the constant errors.%s instead of the string literal %s", name, lit.Value),
+	}
+	if local := importName(f); local != "" {
+		d.SuggestedFixes = []analysis.SuggestedFix{{
+			Message:   fmt.Sprintf("Replace with %s.%s", local, name),
+			TextEdits: []analysis.TextEdit{{Pos: lit.Pos(), End: lit.End(), NewText: []byte(local + "." + name)}},
+		}}
+	}
+	pass.Report(d)
+}
+
+func checkErrorf(pass *analysis.Pass, call *ast.CallExpr, f *ast.File) {
+	if len(call.Args) == 0 {
+		return
+	}
+	tv := pass.TypesInfo.Types[call.Args[0]]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+	if !strings.Contains(constant.StringVal(tv.Value), "%w") {
+		return
+	}
+
+	d := analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: "use errors.Errorf() instead of fmt.Errorf() to include the code location",
+	}
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if local := importName(f); local != "" {
+			d.SuggestedFixes = []analysis.SuggestedFix{{
+				Message:   fmt.Sprintf("Replace with %s.Errorf", local),
+				TextEdits: []analysis.TextEdit{{Pos: sel.Pos(), End: sel.End(), NewText: []byte(local + ".Errorf")}},
+			}}
+		}
+	}
+	pass.Report(d)
+}
+
+// errorsPackage returns the errors package if pkg is or imports it
+func errorsPackage(pkg *types.Package) *types.Package {
+	if pkg.Path() == ErrorsPath {
+		return pkg
+	}
+	for _, imp := range pkg.Imports() {
+		if imp.Path() == ErrorsPath {
+			return imp
+		}
+	}
+	return nil
+}
+
+// otelConstants returns the name of each OTEL constant in the errors package keyed by value
+func otelConstants(pkg *types.Package) map[string]string {
+	result := make(map[string]string)
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !strings.HasPrefix(name, "Otel") || c.Val().Kind() != constant.String {
+			continue
+		}
+		result[constant.StringVal(c.Val())] = name
+	}
+	return result
+}
+
+// isAttr returns true if t is slog.Attr
+func isAttr(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == "log/slog" && named.Obj().Name() == "Attr"
+}
+
+// importName returns the name the errors package is imported as in f, or an empty
+// string if f does not import the errors package.
+func importName(f *ast.File) string {
+	if f == nil {
+		return ""
+	}
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || path != ErrorsPath {
+			continue
+		}
+		if imp.Name != nil {
+			if imp.Name.Name == "_" || imp.Name.Name == "." {
+				return ""
+			}
+			return imp.Name.Name
+		}
+		return "errors"
+	}
+	return ""
+}
+
+func file(stack []ast.Node) *ast.File {
+	if len(stack) == 0 {
+		return nil
+	}
+	f, _ := stack[0].(*ast.File)
+	return f
+}
+
+func render(pass *analysis.Pass, e ast.Expr) string {
+	if tv := pass.TypesInfo.Types[e]; tv.Value != nil {
+		return tv.Value.ExactString()
+	}
+	return types.ExprString(e)
+}
@@ -0,0 +1,12 @@
+package withargs_test
+
+import (
+	"testing"
+
+	"github.com/kapetan-io/errors/analysis/withargs"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), withargs.Analyzer, "a", "b")
+}
@@ -7,6 +7,10 @@ import (
 	"io"
 	"log/slog"
 	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // HasAttrs is used identify which errors have attributes attached in order to pass along unstructured
@@ -36,10 +40,12 @@ func WithAttr(attrs ...slog.Attr) *Attrs {
 func Error(msg string) error {
 	var pcs [1]uintptr
 	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	a := &Attrs{}
 	return &ErrAttrs{
 		wrapped: errors.New(msg),
-		attrs:   &Attrs{},
+		attrs:   a,
 		pc:      pcs[0],
+		stack:   a.callers(),
 	}
 }
 
@@ -49,10 +55,12 @@ func Error(msg string) error {
 func Errorf(format string, args ...any) error {
 	var pcs [1]uintptr
 	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	a := &Attrs{}
 	return &ErrAttrs{
 		wrapped: fmt.Errorf(format, args...),
-		attrs:   &Attrs{},
+		attrs:   a,
 		pc:      pcs[0],
+		stack:   a.callers(),
 	}
 }
 
@@ -66,29 +74,24 @@ func Wrap(err error) error {
 	}
 	var pcs [1]uintptr
 	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	a := &Attrs{}
 	return &ErrAttrs{
-		attrs:   &Attrs{},
+		attrs:   a,
 		pc:      pcs[0],
+		stack:   a.callers(),
 		wrapped: err,
+		wrap:    true,
 	}
 }
 
-// Logger is a crazy idea which would extract the attributes from
-// the currently configured logger.
-//
-//  log := slog.With("foo", "bar")
-//  return errors.Logger(log).Error("my error message")
-//
-//func Logger(l *slog.Logger) *Attrs {
-//  a := &Attrs{}
-//	// Get the attributes somehow?
-//	return a.WithAttr(l.GetAttr())
-//}
-
 // Attrs holds attached attributes until Error() or Errorf() are called to
 // return the attributes via ErrAttrs as an error.
 type Attrs struct {
-	attrs []slog.Attr
+	attrs      []slog.Attr
+	stack      bool
+	kind       Kind
+	retryable  bool
+	retryAfter time.Duration
 }
 
 // With returns a new *Attrs which includes the given attributes combined
@@ -100,7 +103,45 @@ func (a *Attrs) With(args ...any) *Attrs {
 // WithAttr returns a new *Attrs which includes the given attributes combined
 // with any existing attributes defined in the current Attrs.
 func (a *Attrs) WithAttr(as ...slog.Attr) *Attrs {
-	return &Attrs{attrs: append(a.attrs, as...)}
+	n := *a
+	n.attrs = make([]slog.Attr, 0, len(a.attrs)+len(as))
+	n.attrs = appendAttrs(append(n.attrs, a.attrs...), as)
+	return &n
+}
+
+// appendAttrs appends the attrs, inlining groups with an empty key in the same way slog does
+func appendAttrs(attrs []slog.Attr, as []slog.Attr) []slog.Attr {
+	for _, a := range as {
+		if a.Key == "" && a.Value.Kind() == slog.KindGroup {
+			attrs = appendAttrs(attrs, a.Value.Group())
+			continue
+		}
+		attrs = append(attrs, a)
+	}
+	return attrs
+}
+
+// WithStack returns a new *Attrs which captures the full call stack when
+// Error(), Errorf() or Wrap() is called, regardless of SetCaptureStack().
+// The stack can be extracted with errors.AttrsFromWithCodeLoc() or HasStack.
+func (a *Attrs) WithStack() *Attrs {
+	n := *a
+	n.stack = true
+	return &n
+}
+
+// LogValue implements slog.LogValuer and returns the attributes as a group
+func (a *Attrs) LogValue() slog.Value {
+	return slog.GroupValue(a.all()...)
+}
+
+// all returns the attributes including the OTEL 'error.type'
+// attribute if a Kind was attached.
+func (a *Attrs) all() []slog.Attr {
+	if a.kind == "" {
+		return a.attrs
+	}
+	return append(slices.Clip(a.attrs), kindAttr(a.kind))
 }
 
 // Wrap returns an error with included code location information
@@ -115,7 +156,9 @@ func (a *Attrs) Wrap(err error) error {
 	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
 	return &ErrAttrs{
 		pc:      pcs[0],
+		stack:   a.callers(),
 		wrapped: err,
+		wrap:    true,
 		attrs:   a,
 	}
 }
@@ -129,6 +172,7 @@ func (a *Attrs) Error(msg string) error {
 	return &ErrAttrs{
 		wrapped: errors.New(msg),
 		pc:      pcs[0],
+		stack:   a.callers(),
 		attrs:   a,
 	}
 }
@@ -142,6 +186,30 @@ func (a *Attrs) Errorf(format string, args ...any) error {
 	return &ErrAttrs{
 		wrapped: fmt.Errorf(format, args...),
 		pc:      pcs[0],
+		stack:   a.callers(),
+		attrs:   a,
+	}
+}
+
+// Join works exactly like standard lib `errors.Join()` and includes code
+// location information at the point Join is called. Any nil error values
+// are discarded, Join returns nil if every value in errs is nil.
+//
+// Attributes from each of the joined errors are grouped under the index of the
+// error, and `%+v` lists each error with its attributes and code location.
+//
+//	err := errors.With("job", "fan-out").Join(err1, err2)
+func (a *Attrs) Join(errs ...error) error {
+	var pcs [1]uintptr
+	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	j := newJoinErrs(errs)
+	if j == nil {
+		return nil
+	}
+	return &ErrAttrs{
+		wrapped: j,
+		pc:      pcs[0],
+		stack:   a.callers(),
 		attrs:   a,
 	}
 }
@@ -149,8 +217,17 @@ func (a *Attrs) Errorf(format string, args ...any) error {
 // ErrAttrs is an error which has slog.Attr attached
 type ErrAttrs struct {
 	pc      uintptr
+	stack   []uintptr
 	attrs   *Attrs
 	wrapped error
+	// wrap is true if created by Wrap() in which case
+	// wrapped is the error provided by the caller.
+	wrap bool
+	// frame and frames are the code location and stack used
+	// when no pc is available, as is the case when decoded by
+	// UnmarshalJSON()
+	frame  runtime.Frame
+	frames []runtime.Frame
 }
 
 // Error returns the error as a string
@@ -158,16 +235,42 @@ func (e *ErrAttrs) Error() string {
 	return e.wrapped.Error()
 }
 
-// Is returns true if the target is of type ErrAttrs
+// Is returns true if the target is the Kind attached to this error. This allows
+// errors.Is() to match a Kind anywhere in the err tree.
+//
+//	if errors.Is(err, errors.NotFound) {
+//
+// To check if the err tree contains any ErrAttrs use an empty ErrAttrs as the target
+//
+//	if errors.Is(err, &errors.ErrAttrs{}) {
 func (e *ErrAttrs) Is(target error) bool {
-	_, ok := target.(*ErrAttrs)
-	return ok
+	switch t := target.(type) {
+	case Kind:
+		return e.attrs.kind != "" && e.attrs.kind == t
+	case *ErrAttrs:
+		return t == nil || t.wrapped == nil
+	}
+	return false
+}
+
+// Kind returns the Kind attached to this error or the first
+// Kind found in the err tree. See KindOf()
+func (e *ErrAttrs) Kind() Kind {
+	if e.attrs.kind != "" {
+		return e.attrs.kind
+	}
+	return KindOf(e.wrapped)
 }
 
 // Unwrap returns the result of calling the Unwrap method on err, if err's
 // type contains an Unwrap method returning error.
 // Otherwise, Unwrap returns nil.
+//
+// If the error was created by Wrap() the wrapped error is returned.
 func (e *ErrAttrs) Unwrap() error {
+	if e.wrap {
+		return e.wrapped
+	}
 	u, ok := e.wrapped.(interface {
 		Unwrap() error
 	})
@@ -179,33 +282,52 @@ func (e *ErrAttrs) Unwrap() error {
 
 // Attrs recursively returns all attributes in the err tree.
 // The pc returned is from the ErrAttrs closest to the root of the
-// err tree.
+// err tree. If the err tree branches via `Unwrap() []error` as is the
+// case with `errors.Join()`, the attributes from each branch are grouped
+// under the index of the branch. See AttrsFrom()
 func (e *ErrAttrs) Attrs() ([]slog.Attr, uintptr) {
 	var result []slog.Attr
-	result = append(result, e.attrs.attrs...)
+	result = append(result, e.attrs.all()...)
 	pc := e.pc
 
-	var (
-		child []slog.Attr
-		a     HasAttrs
-	)
-	if errors.As(e.wrapped, &a) {
-		child, pc = a.Attrs()
-		if child == nil {
-			return result, pc
-		}
+	child, childPC, ok := attrsFromTree(e.wrapped)
+	if ok {
+		pc = childPC
 		result = append(result, child...)
 	}
 	return result, pc
 }
 
+// Stack returns the full call stack captured by the ErrAttrs closest to
+// the root of the err tree which captured a stack. Returns nil if no stack
+// was captured. See SetCaptureStack() and Attrs.WithStack()
+func (e *ErrAttrs) Stack() []uintptr {
+	var s HasStack
+	if errors.As(e.wrapped, &s) {
+		if pcs := s.Stack(); pcs != nil {
+			return pcs
+		}
+	}
+	return e.stack
+}
+
 // Format follows the standard set forth by the fmt package
 // for serializing structures using formating directives %s, %v, %+v, %q
 func (e *ErrAttrs) Format(s fmt.State, verb rune) {
 	switch verb {
 	case 'v':
 		if s.Flag('+') {
-			_, _ = fmt.Fprintf(s, "%+v (%s)", e.wrapped, e.formatAttrs())
+			if j, ok := e.wrapped.(*joinErrs); ok {
+				e.formatJoin(s, j)
+				return
+			}
+			_, _ = fmt.Fprintf(s, "%s (%s)", RedactMessage(fmt.Sprintf("%+v", e.wrapped)), e.formatAttrs())
+			// A directly wrapped ErrAttrs has already written the stack
+			if _, ok := e.wrapped.(*ErrAttrs); !ok {
+				if frames := e.stackFrames(); len(frames) != 0 {
+					_, _ = io.WriteString(s, "\n"+formatStack(frames))
+				}
+			}
 			return
 		}
 		fallthrough
@@ -215,30 +337,99 @@ func (e *ErrAttrs) Format(s fmt.State, verb rune) {
 	}
 }
 
+// LogValue implements slog.LogValuer and returns a group containing the error
+// message, all attributes in the err tree and the code location where the error
+// was created. This allows the error to be logged with `slog.Any("err", err)`
+//
+//	{"err":{"msg":"query failed","foo":"bar","code.filepath":"...","code.function":"...","code.lineno":16}}
+func (e *ErrAttrs) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.String(slog.MessageKey, e.Error())}
+	return slog.GroupValue(append(attrs, AttrsFromWithCodeLoc(e)...)...)
+}
+
+// codeLoc returns the code location where this error was created
+func (e *ErrAttrs) codeLoc() runtime.Frame {
+	if e.pc != 0 {
+		return frameFromPC(e.pc)
+	}
+	return e.frame
+}
+
+// stackFrames returns the frames of the stack closest to the
+// root of the err tree. See Stack()
+func (e *ErrAttrs) stackFrames() []runtime.Frame {
+	var s HasStack
+	if errors.As(e.wrapped, &s) {
+		if frames := framesFromStack(s); frames != nil {
+			return frames
+		}
+	}
+	if e.stack != nil {
+		return framesFromPCs(e.stack)
+	}
+	return e.frames
+}
+
 func (e *ErrAttrs) formatAttrs() string {
+	attrs, _ := e.Attrs()
+	return formatAttrs(attrs)
+}
+
+// formatJoin writes the attributes of e followed by each joined
+// error with its attributes and code location on a separate line.
+func (e *ErrAttrs) formatJoin(s fmt.State, j *joinErrs) {
+	_, _ = fmt.Fprintf(s, "%d errors (%s)", len(j.errs), formatAttrs(e.attrs.all()))
+	for i, err := range j.errs {
+		child := fmt.Sprintf("%+v", err)
+		// ErrAttrs redact their own message when formatted
+		if _, ok := err.(*ErrAttrs); !ok {
+			child = RedactMessage(child)
+		}
+		if f, ok := frameFromTree(err); ok && f.Function != "" {
+			loc := fmt.Sprintf(" at %s %s:%d", f.Function, f.File, f.Line)
+			if n := strings.IndexByte(child, '\n'); n != -1 {
+				child = child[:n] + loc + child[n:]
+			} else {
+				child += loc
+			}
+		}
+		_, _ = fmt.Fprintf(s, "\n[%d] %s", i, strings.ReplaceAll(child, "\n", "\n\t"))
+	}
+}
+
+func formatAttrs(attrs []slog.Attr) string {
 	var buf bytes.Buffer
 	var count int
 
-	attrs, _ := e.Attrs()
-	for _, attr := range attrs {
+	for _, attr := range redact(attrs) {
 		if count > 0 {
 			buf.WriteString(", ")
 		}
-		buf.WriteString(fmt.Sprintf("%+v=%+v", attr.Key, attr.Value.Any()))
+		buf.WriteString(fmt.Sprintf("%+v=%+v", attr.Key, attr.Value.Resolve().Any()))
 		count++
 	}
 	return buf.String()
 }
 
-// AttrsFrom returns any attrs from the err tree. If the err tree contains
+// AttrsFrom returns any attrs from the err tree, with the MergePolicy set by
+// SetMergePolicy() and the Redactor set by SetRedactor() applied. If the err tree contains
 // no instances of HasAttrs then []slog.Attr{slog.Any("", nil)} is returned.
 // This means it is safe to call with `slog.LogAttrs()` even if there are no
 // attributes in the err tree.
+//
+// The entire err tree is walked, including errors which implement `Unwrap() []error`
+// such as those returned by `errors.Join()` or `fmt.Errorf()` with multiple `%w`.
+// Attributes are returned in order from the top of the err tree to the root, the
+// attributes found in each branch of a multi error are grouped under the index of
+// the branch which produced them.
+//
+//	err := errors.Join(errors.With("foo", "bar").Error("one"), errors.With("foo", "baz").Error("two"))
+//
+//	// Prints `[0=[foo=bar] 1=[foo=baz]]`
+//	fmt.Printf("%v\n", errors.AttrsFrom(err))
 func AttrsFrom(err error) []slog.Attr {
-	var a HasAttrs
-	if errors.As(err, &a) {
-		attrs, _ := a.Attrs()
-		return attrs
+	if attrs, ok := mergedAttrsFromTree(err); ok {
+		return redact(attrs)
 	}
 	return []slog.Attr{slog.Any("", nil)}
 }
@@ -250,14 +441,12 @@ func AttrsFromWithErr(err error) []slog.Attr {
 		return []slog.Attr{slog.Any("", nil)}
 	}
 
-	var a HasAttrs
-	if errors.As(err, &a) {
+	if attrs, ok := mergedAttrsFromTree(err); ok {
 		result := []slog.Attr{slog.String("error", err.Error())}
-		attrs, _ := a.Attrs()
 		result = append(result, attrs...)
-		return result
+		return redact(result)
 	}
-	return []slog.Attr{slog.String("error", err.Error())}
+	return redact([]slog.Attr{slog.String("error", err.Error())})
 }
 
 // AttrsFromWithCodeLoc returns any attrs from the err tree and includes source code from the
@@ -268,43 +457,118 @@ func AttrsFromWithErr(err error) []slog.Attr {
 //	code.function Struct.Method
 //	code.lineno 156
 //
+// If the full call stack was captured (see SetCaptureStack() and Attrs.WithStack())
+// it is included as the OTEL field 'exception.stacktrace'.
+//
 // If the err tree contains no instances of HasAttrs then
 // []slog.Attr{slog.Any("", nil)} is returned.
 func AttrsFromWithCodeLoc(err error) []slog.Attr {
-	var a HasAttrs
-	if errors.As(err, &a) {
-		attrs, pc := a.Attrs()
-		attrs = append(attrs, attrsFromPC(pc)...)
-		return attrs
+	if attrs, ok := mergedAttrsFromTree(err); ok {
+		f, _ := frameFromTree(err)
+		attrs = append(attrs, attrsFromFrame(f)...)
+		attrs = append(attrs, attrsFromStack(err)...)
+		return redact(attrs)
 	}
 	return []slog.Attr{slog.Any("", nil)}
 }
 
 // AttrsFromAll returns all possible attributes extracted from the passed error.
 // Equivalent to calling AttrsFromWithErr and AttrsFromWithCodeLoc and combining
-// all the attributes.
+// all the attributes. The FingerprintKey attribute is included when enabled
+// with SetIncludeFingerprint().
 func AttrsFromAll(err error) []slog.Attr {
 	if err == nil {
 		return []slog.Attr{slog.Any("", nil)}
 	}
 
-	var a HasAttrs
-	if errors.As(err, &a) {
+	if attrs, ok := mergedAttrsFromTree(err); ok {
 		result := []slog.Attr{slog.String("error", err.Error())}
-		attrs, pc := a.Attrs()
 		result = append(result, attrs...)
-		result = append(result, attrsFromPC(pc)...)
-		return result
+		f, _ := frameFromTree(err)
+		result = append(result, attrsFromFrame(f)...)
+		result = append(result, attrsFromStack(err)...)
+		return redact(append(result, fingerprintAttr(err)...))
 	}
-	return []slog.Attr{slog.Any("error", err.Error())}
+	return redact(append([]slog.Attr{slog.Any("error", err.Error())}, fingerprintAttr(err)...))
 }
 
 // --------------------------
 // Private methods
 // --------------------------
 
-func attrsFromPC(pc uintptr) []slog.Attr {
+// attrsFromTree walks the err tree and returns the attributes from the first HasAttrs
+// found in each branch of the tree, along with the pc from the first branch. Returns
+// false if the err tree contains no instances of HasAttrs.
+func attrsFromTree(err error) ([]slog.Attr, uintptr, bool) {
+	for err != nil {
+		switch x := err.(type) {
+		case HasAttrs:
+			attrs, pc := x.Attrs()
+			return attrs, pc, true
+		case interface{ Unwrap() []error }:
+			var (
+				result []slog.Attr
+				pc     uintptr
+				found  bool
+			)
+			for i, branch := range x.Unwrap() {
+				attrs, branchPC, ok := attrsFromTree(branch)
+				if !ok {
+					continue
+				}
+				if !found {
+					pc, found = branchPC, true
+				}
+				if len(attrs) != 0 {
+					result = append(result, slog.Attr{Key: strconv.Itoa(i), Value: slog.GroupValue(attrs...)})
+				}
+			}
+			return result, pc, found
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		default:
+			return nil, 0, false
+		}
+	}
+	return nil, 0, false
+}
+
+// frameFromTree walks the err tree and returns the code location of the HasAttrs
+// closest to the root of the first branch of the tree which contains a HasAttrs.
+// Returns false if the err tree contains no instances of HasAttrs.
+func frameFromTree(err error) (runtime.Frame, bool) {
+	for err != nil {
+		switch x := err.(type) {
+		case *ErrAttrs:
+			if f, ok := frameFromTree(x.wrapped); ok {
+				return f, true
+			}
+			return x.codeLoc(), true
+		case HasAttrs:
+			_, pc := x.Attrs()
+			return frameFromPC(pc), true
+		case interface{ Unwrap() []error }:
+			for _, branch := range x.Unwrap() {
+				if f, ok := frameFromTree(branch); ok {
+					return f, true
+				}
+			}
+			return runtime.Frame{}, false
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		default:
+			return runtime.Frame{}, false
+		}
+	}
+	return runtime.Frame{}, false
+}
+
+func frameFromPC(pc uintptr) runtime.Frame {
 	f, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	return f
+}
+
+func attrsFromFrame(f runtime.Frame) []slog.Attr {
 	return []slog.Attr{
 		slog.String(OtelCodeFilePath, f.File),
 		slog.String(OtelCodeFunction, f.Function),
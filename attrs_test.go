@@ -186,3 +186,24 @@ func TestAttrsWithErr(t *testing.T) {
 	assert.Contains(t, w.String(), "error=\"this is an error\"")
 
 }
+
+func TestLogValuer(t *testing.T) {
+	var w bytes.Buffer
+	log := slog.New(slog.NewJSONHandler(&w, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		}}))
+
+	err := errors.With("foo", "bar").Error("query failed")
+	log.Error("failed", slog.Any("err", err))
+
+	assert.Contains(t, w.String(), `"err":{"msg":"query failed","foo":"bar","code.filepath":"`)
+	assert.Regexp(t, `"code.function":"github.com/kapetan-io/errors_test.TestLogValuer","code.lineno":\d+}}`, w.String())
+
+	w.Reset()
+	log.Error("attrs", slog.Any("attrs", errors.With("foo", "bar", "count", 1)))
+	assert.Contains(t, w.String(), `"attrs":{"foo":"bar","count":1}`)
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type line struct {
+	op   byte
+	text string
+}
+
+// diff returns a unified diff of the changes between a and b
+func diff(name string, a, b []byte) string {
+	x := strings.SplitAfter(string(a), "\n")
+	y := strings.SplitAfter(string(b), "\n")
+
+	lines := edits(nil, x, y)
+
+	const context = 3
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", name, name)
+	ai, bi, pos := 1, 1, 0
+	for start := 0; start < len(lines); {
+		// Find the next change
+		for start < len(lines) && lines[start].op == ' ' {
+			start++
+		}
+		if start == len(lines) {
+			break
+		}
+		from := max(start-context, 0)
+		// Extend the hunk until there are more than 2*context unchanged lines
+		end, same := start, 0
+		for end < len(lines) && same <= 2*context {
+			if lines[end].op == ' ' {
+				same++
+			} else {
+				same = 0
+			}
+			end++
+		}
+		end -= max(same-context, 0)
+
+		// Line numbers of the hunk in a and b
+		for ; pos < from; pos++ {
+			if lines[pos].op != '+' {
+				ai++
+			}
+			if lines[pos].op != '-' {
+				bi++
+			}
+		}
+		var an, bn int
+		for _, l := range lines[from:end] {
+			if l.op != '+' {
+				an++
+			}
+			if l.op != '-' {
+				bn++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", ai, an, bi, bn)
+		for _, l := range lines[from:end] {
+			out.WriteByte(l.op)
+			out.WriteString(strings.TrimSuffix(l.text, "\n"))
+			out.WriteByte('\n')
+		}
+		start = end
+	}
+	return out.String()
+}
+
+// edits appends the lines of x and y to lines marked as unchanged ' ', removed '-' or added '+'
+// using the linear space variation of Myers' algorithm, see "An O(ND) Difference Algorithm and
+// Its Variations". The common prefix and suffix are trimmed first as most lines are unchanged.
+func edits(lines []line, x, y []string) []line {
+	for len(x) != 0 && len(y) != 0 && x[0] == y[0] {
+		lines = append(lines, line{' ', x[0]})
+		x, y = x[1:], y[1:]
+	}
+	var n int
+	for n < len(x) && n < len(y) && x[len(x)-1-n] == y[len(y)-1-n] {
+		n++
+	}
+	suffix := x[len(x)-n:]
+	x, y = x[:len(x)-n], y[:len(y)-n]
+
+	i, j := -1, -1
+	if len(x) != 0 && len(y) != 0 {
+		i, j = bisect(x, y)
+	}
+	if i == -1 {
+		for _, l := range x {
+			lines = append(lines, line{'-', l})
+		}
+		for _, l := range y {
+			lines = append(lines, line{'+', l})
+		}
+	} else {
+		lines = edits(lines, x[:i], y[:j])
+		lines = edits(lines, x[i:], y[j:])
+	}
+
+	for _, l := range suffix {
+		lines = append(lines, line{' ', l})
+	}
+	return lines
+}
+
+// bisect returns the point where the shortest edit script of x into y found searching forward
+// from the start meets the one found searching backward from the end, or -1 if x and y have
+// no lines in common. x and y must not be empty, nor have a common first or last line.
+func bisect(x, y []string) (int, int) {
+	n, m := len(x), len(y)
+	maxD := (n + m + 1) / 2
+	offset := maxD + 1
+	// forward[k] and backward[k] are the furthest x reached on diagonal k from the start and the end
+	forward := make([]int, 2*offset+1)
+	backward := make([]int, 2*offset+1)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	// The forward search checks for an overlap when delta is odd, else the backward search does
+	odd := delta%2 != 0
+	// Diagonals which went past the edge of the grid are skipped
+	var fStart, fEnd, bStart, bEnd int
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var i int
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				i = forward[offset+k+1]
+			} else {
+				i = forward[offset+k-1] + 1
+			}
+			j := i - k
+			for i < n && j < m && x[i] == y[j] {
+				i, j = i+1, j+1
+			}
+			forward[offset+k] = i
+			switch {
+			case i > n:
+				fEnd += 2
+			case j > m:
+				fStart += 2
+			case odd:
+				if b := offset + delta - k; b >= 0 && b < len(backward) && backward[b] != -1 && i >= n-backward[b] {
+					return i, j
+				}
+			}
+		}
+
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var i int
+			if k == -d || (k != d && backward[offset+k-1] < backward[offset+k+1]) {
+				i = backward[offset+k+1]
+			} else {
+				i = backward[offset+k-1] + 1
+			}
+			j := i - k
+			for i < n && j < m && x[n-i-1] == y[m-j-1] {
+				i, j = i+1, j+1
+			}
+			backward[offset+k] = i
+			switch {
+			case i > n:
+				bEnd += 2
+			case j > m:
+				bStart += 2
+			case !odd:
+				if f := offset + delta - k; f >= 0 && f < len(forward) && forward[f] != -1 && forward[f] >= n-i {
+					return forward[f], forward[f] - (delta - k)
+				}
+			}
+		}
+	}
+	return -1, -1
+}
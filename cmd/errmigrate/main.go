@@ -0,0 +1,145 @@
+// Command errmigrate rewrites uses of github.com/pkg/errors and fmt.Errorf() into the
+// equivalent calls of this package and reports the uses which could not be translated.
+//
+//	# Print the changes as a diff
+//	errmigrate ./...
+//
+//	# Rewrite the files, moving values embedded in messages into attributes
+//	errmigrate -w -attrs user=user.id,host=server.address ./...
+//
+// Translations
+//
+//	errors.New("msg")                      -> errors.Error("msg")
+//	errors.Wrap(err, "msg")                -> errors.Errorf("msg: %w", err)
+//	errors.Wrapf(err, "msg %s", v)         -> errors.Errorf("msg %s: %w", v, err)
+//	errors.WithMessage(err, "msg")         -> errors.Errorf("msg: %w", err)
+//	errors.WithStack(err)                  -> errors.WithStack(err)
+//	errors.Cause(err)                      -> errors.Cause(err)
+//	errors.Cause(err) == ErrNotFound       -> errors.Is(err, ErrNotFound)
+//	fmt.Errorf("user %s: %w", id, err)     -> errors.With("user.id", id).Errorf("user: %w", err)
+//
+// pkg/errors.Wrap() returns nil when err is nil while errors.Errorf() does not, as such
+// Wrap(), Wrapf(), WithMessage() and WithMessagef() are only translated to Errorf() when
+// called within an `if err != nil` block. Otherwise the nil safe equivalents are used.
+//
+//	errors.Wrap(err, "msg")                -> errors.Wrapf(err, "msg")
+//	errors.WithMessage(err, "msg")         -> errors.WithMessage(err, "msg")
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "errmigrate: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("errmigrate", flag.ContinueOnError)
+	write := fs.Bool("w", false, "write the changes to the files instead of printing a diff")
+	attrs := fs.String("attrs", "", "comma separated list of word=key, values which follow "+
+		"the word in a message are moved into an attribute with the key")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	opts := Options{Attrs: make(map[string]string)}
+	for _, kv := range strings.Split(*attrs, ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		word, key, ok := strings.Cut(kv, "=")
+		if !ok || word == "" || key == "" {
+			return fmt.Errorf("invalid -attrs '%s'; expected word=key", kv)
+		}
+		opts.Attrs[word] = key
+	}
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	files, err := goFiles(paths)
+	if err != nil {
+		return err
+	}
+
+	var changed, issues int
+	fset := token.NewFileSet()
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		r, err := migrate(fset, file, src, opts)
+		if err != nil {
+			return err
+		}
+		for _, i := range r.Issues {
+			fmt.Fprintln(os.Stderr, i)
+		}
+		issues += len(r.Issues)
+		if !r.Changed {
+			continue
+		}
+		changed++
+		if *write {
+			if err := os.WriteFile(file, r.Src, 0644); err != nil {
+				return err
+			}
+			continue
+		}
+		fmt.Print(diff(file, src, r.Src))
+	}
+	fmt.Fprintf(os.Stderr, "%d files changed, %d uses could not be translated\n", changed, issues)
+	return nil
+}
+
+// goFiles returns the Go files of the paths, a path ending in '/...' includes sub directories
+func goFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		root, recursive := strings.CutSuffix(p, "/...")
+		if root == "" {
+			root = "."
+		}
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, root)
+			continue
+		}
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				name := d.Name()
+				if path != root && (!recursive || name == "vendor" || name == "testdata" ||
+					strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasSuffix(path, ".go") {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,538 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	errorsPath    = "github.com/kapetan-io/errors"
+	pkgErrorsPath = "github.com/pkg/errors"
+	// pkgErrorsAlias is the import name of pkg/errors when some uses could not be translated
+	pkgErrorsAlias = "pkgerrors"
+)
+
+// Options control how calls are translated
+type Options struct {
+	// Attrs maps a word which precedes a verb in a format string to an attribute key, such
+	// that `fmt.Errorf("user %s not found", id)` is translated to
+	// `errors.With("user.id", id).Errorf("user not found")` when Attrs is {"user": "user.id"}
+	Attrs map[string]string
+}
+
+// Issue is a use of pkg/errors or fmt which could not be translated
+type Issue struct {
+	Pos  token.Position
+	Msg  string
+	Code string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s\n\t%s", i.Pos, i.Msg, i.Code)
+}
+
+// Result is the result of migrating a single file
+type Result struct {
+	Src     []byte
+	Changed bool
+	Issues  []Issue
+}
+
+type migrator struct {
+	fset    *token.FileSet
+	src     []byte
+	opts    Options
+	pkgName string
+	fmtName string
+	// done are the selectors which have been translated
+	done    map[*ast.SelectorExpr]bool
+	issues  []Issue
+	changed bool
+}
+
+// migrate translates the uses of pkg/errors and fmt.Errorf() in src into the
+// equivalent calls of the errors package and fixes the imports.
+func migrate(fset *token.FileSet, filename string, src []byte, opts Options) (Result, error) {
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return Result{}, err
+	}
+
+	m := &migrator{fset: fset, src: src, opts: opts, done: make(map[*ast.SelectorExpr]bool)}
+	m.pkgName = importName(f, pkgErrorsPath, "errors")
+	m.fmtName = importName(f, "fmt", "fmt")
+	if m.pkgName == "" && m.fmtName == "" {
+		return Result{Src: src}, nil
+	}
+
+	var stack []ast.Node
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		if call, ok := n.(*ast.CallExpr); ok {
+			m.call(call, stack)
+		}
+		stack = append(stack, n)
+		return true
+	})
+
+	if !m.changed {
+		return Result{Src: src, Issues: m.issues}, nil
+	}
+	m.fixImports(f)
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return Result{}, err
+	}
+	return Result{Src: buf.Bytes(), Changed: true, Issues: m.issues}, nil
+}
+
+func (m *migrator) call(call *ast.CallExpr, stack []ast.Node) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	x, ok := sel.X.(*ast.Ident)
+	// Package names are not resolved by the parser, local variables are
+	if !ok || x.Obj != nil || m.done[sel] {
+		return
+	}
+
+	switch x.Name {
+	case m.fmtName:
+		if sel.Sel.Name == "Errorf" {
+			m.errorf(call, sel)
+		}
+	case m.pkgName:
+		m.pkgErrors(call, sel, stack)
+	}
+}
+
+func (m *migrator) pkgErrors(call *ast.CallExpr, sel *ast.SelectorExpr, stack []ast.Node) {
+	switch sel.Sel.Name {
+	case "New":
+		m.rename(sel, "Error")
+	case "Is", "As", "Unwrap":
+		m.rename(sel, sel.Sel.Name)
+	case "Errorf":
+		m.errorf(call, sel)
+	case "Cause":
+		if !m.causeIs(call, sel, stack) {
+			m.rename(sel, sel.Sel.Name)
+		}
+	case "WithStack":
+		m.rename(sel, sel.Sel.Name)
+	case "Wrap", "WithMessage":
+		if len(call.Args) != 2 {
+			return
+		}
+		// The nil safe equivalents are used when err might be nil
+		if !guarded(call.Args[0], stack) {
+			if sel.Sel.Name == "Wrap" {
+				call.Args = append(call.Args[:1], wrapfArgs(call.Args[1])...)
+				m.rename(sel, "Wrapf")
+				return
+			}
+			m.rename(sel, sel.Sel.Name)
+			return
+		}
+		format, args := wrapFormat(call.Args[1])
+		call.Args = append(args, call.Args[0])
+		m.rename(sel, "Errorf")
+		m.withAttrs(call, sel, format)
+	case "Wrapf", "WithMessagef":
+		if len(call.Args) < 2 {
+			return
+		}
+		if !guarded(call.Args[0], stack) {
+			m.rename(sel, sel.Sel.Name)
+			return
+		}
+		lit, ok := stringLit(call.Args[1])
+		if !ok {
+			m.issue(call, "format is not a string literal")
+			return
+		}
+		call.Args = append(call.Args[2:], call.Args[0])
+		m.rename(sel, "Errorf")
+		m.withAttrs(call, sel, escape(lit, false)+": %w")
+	default:
+		m.issue(call, fmt.Sprintf("%s() has no equivalent", sel.Sel.Name))
+	}
+}
+
+// causeIs replaces a comparison with the result of Cause() such as `errors.Cause(err) == ErrNotFound`
+// with `errors.Is(err, ErrNotFound)`, as Cause() of the errors package does not unwrap past an error
+// created by the errors package, which includes sentinel errors created with errors.Error()
+func (m *migrator) causeIs(call *ast.CallExpr, sel *ast.SelectorExpr, stack []ast.Node) bool {
+	if len(call.Args) != 1 || len(stack) < 2 {
+		return false
+	}
+	be, ok := stack[len(stack)-1].(*ast.BinaryExpr)
+	if !ok || (be.Op != token.EQL && be.Op != token.NEQ) {
+		return false
+	}
+	target := be.Y
+	if be.Y == call {
+		target = be.X
+	} else if be.X != call {
+		return false
+	}
+	// `errors.Cause(err) == nil` is equivalent to `err == nil`
+	if isIdent(target, "nil") {
+		return false
+	}
+
+	var is ast.Expr = call
+	if be.Op == token.NEQ {
+		is = &ast.UnaryExpr{OpPos: be.Pos(), Op: token.NOT, X: call}
+	}
+	if !replace(stack[len(stack)-2], be, is) {
+		return false
+	}
+	call.Args = append(call.Args, target)
+	m.rename(sel, "Is")
+	return true
+}
+
+func (m *migrator) errorf(call *ast.CallExpr, sel *ast.SelectorExpr) {
+	m.rename(sel, "Errorf")
+	if len(call.Args) == 0 {
+		return
+	}
+	if lit, ok := stringLit(call.Args[0]); ok {
+		call.Args = call.Args[1:]
+		m.withAttrs(call, sel, lit)
+	}
+}
+
+// withAttrs sets the format of the Errorf() call, moving values embedded in the
+// format into errors.With() attributes when the preceding word is in Options.Attrs
+func (m *migrator) withAttrs(call *ast.CallExpr, sel *ast.SelectorExpr, format string) {
+	format, call.Args, sel.X = extractAttrs(format, call.Args, m.opts.Attrs, sel.X)
+	if with, ok := sel.X.(*ast.CallExpr); ok {
+		m.done[with.Fun.(*ast.SelectorExpr)] = true
+	}
+	call.Args = append([]ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(format)}}, call.Args...)
+}
+
+func (m *migrator) rename(sel *ast.SelectorExpr, name string) {
+	sel.X = ast.NewIdent("errors")
+	sel.Sel = ast.NewIdent(name)
+	m.done[sel] = true
+	m.changed = true
+}
+
+func (m *migrator) issue(n ast.Node, msg string) {
+	m.issues = append(m.issues, Issue{
+		Pos:  m.fset.Position(n.Pos()),
+		Msg:  msg,
+		Code: string(m.src[m.fset.Position(n.Pos()).Offset:m.fset.Position(n.End()).Offset]),
+	})
+}
+
+// fixImports replaces the pkg/errors and standard errors imports with the errors package,
+// pkg/errors is kept as pkgErrorsAlias when some uses of it could not be translated.
+func (m *migrator) fixImports(f *ast.File) {
+	var remaining bool
+	if m.pkgName != "" {
+		ast.Inspect(f, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok || m.done[sel] {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); ok && x.Obj == nil && x.Name == m.pkgName {
+				if pkgErrorsTypes[sel.Sel.Name] {
+					m.rename(sel, sel.Sel.Name)
+					return true
+				}
+				x.Name = pkgErrorsAlias
+				remaining = true
+				if !m.reported(sel) {
+					m.issue(sel, fmt.Sprintf("%s has no equivalent", sel.Sel.Name))
+				}
+			}
+			return true
+		})
+	}
+
+	var fmtUsed bool
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok && !m.done[sel] {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Obj == nil && x.Name == m.fmtName {
+				fmtUsed = true
+			}
+		}
+		return true
+	})
+
+	var added bool
+	var first *ast.GenDecl
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		if first == nil {
+			first = gd
+		}
+		specs := gd.Specs[:0]
+		for _, s := range gd.Specs {
+			is := s.(*ast.ImportSpec)
+			path, _ := strconv.Unquote(is.Path.Value)
+			switch path {
+			case pkgErrorsPath:
+				if remaining {
+					is.Name = &ast.Ident{Name: pkgErrorsAlias, NamePos: is.Path.Pos()}
+					specs = append(specs, is)
+					continue
+				}
+				fallthrough
+			case "errors", errorsPath:
+				if added {
+					continue
+				}
+				is.Name = nil
+				is.Path.Value = strconv.Quote(errorsPath)
+				added = true
+			case "fmt":
+				if !fmtUsed && is.Name == nil {
+					// Replace fmt with the errors package to keep the position of the import
+					if !added && !remaining {
+						is.Path.Value = strconv.Quote(errorsPath)
+						added = true
+						break
+					}
+					continue
+				}
+			}
+			specs = append(specs, is)
+		}
+		// Remove the parentheses when only a single import remains
+		if len(specs) == 1 && len(gd.Specs) > 1 {
+			gd.Lparen, gd.Rparen = token.NoPos, token.NoPos
+		}
+		gd.Specs = specs
+	}
+
+	if !added && first != nil {
+		// Use the position of the previous import such that it is printed in the same group
+		pos := first.Specs[len(first.Specs)-1].Pos()
+		first.Specs = append(first.Specs, &ast.ImportSpec{
+			Path:   &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(errorsPath), ValuePos: pos},
+			EndPos: pos,
+		})
+		if !first.Lparen.IsValid() {
+			first.Lparen = first.Specs[0].Pos()
+			first.Rparen = first.End()
+		}
+	}
+
+	// Remove empty import declarations
+	decls := f.Decls[:0]
+	for _, d := range f.Decls {
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT && len(gd.Specs) == 0 {
+			continue
+		}
+		decls = append(decls, d)
+	}
+	f.Decls = decls
+	ast.SortImports(m.fset, f)
+}
+
+func (m *migrator) reported(sel *ast.SelectorExpr) bool {
+	pos := m.fset.Position(sel.Pos())
+	for _, i := range m.issues {
+		if i.Pos == pos {
+			return true
+		}
+	}
+	return false
+}
+
+// importName returns the name of the import with path in f, or an empty string if not imported
+func importName(f *ast.File, path, name string) string {
+	for _, imp := range f.Imports {
+		if p, _ := strconv.Unquote(imp.Path.Value); p != path {
+			continue
+		}
+		if imp.Name != nil {
+			if imp.Name.Name == "_" || imp.Name.Name == "." {
+				return ""
+			}
+			return imp.Name.Name
+		}
+		return name
+	}
+	return ""
+}
+
+// guarded returns true if the call is within an `if err != nil` block where err is the expression
+func guarded(err ast.Expr, stack []ast.Node) bool {
+	id, ok := err.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	for i := len(stack) - 1; i > 0; i-- {
+		// Only the body of the if statement is guarded, not the else branch
+		is, ok := stack[i-1].(*ast.IfStmt)
+		if !ok || stack[i] != is.Body {
+			continue
+		}
+		be, ok := is.Cond.(*ast.BinaryExpr)
+		if !ok || be.Op != token.NEQ {
+			continue
+		}
+		if isIdent(be.X, id.Name) && isIdent(be.Y, "nil") || isIdent(be.Y, id.Name) && isIdent(be.X, "nil") {
+			return true
+		}
+	}
+	return false
+}
+
+// replace replaces the child old of parent with expr, returns false if old is not a child of parent
+func replace(parent ast.Node, old, expr ast.Expr) bool {
+	v := reflect.ValueOf(parent).Elem()
+	set := func(f reflect.Value) bool {
+		if f.Kind() != reflect.Interface || f.IsNil() || f.Interface() != old {
+			return false
+		}
+		if !reflect.TypeOf(expr).AssignableTo(f.Type()) {
+			return false
+		}
+		f.Set(reflect.ValueOf(expr))
+		return true
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() == reflect.Slice {
+			for j := 0; j < f.Len(); j++ {
+				if set(f.Index(j)) {
+					return true
+				}
+			}
+			continue
+		}
+		if set(f) {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdent(e ast.Expr, name string) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == name
+}
+
+// wrapFormat returns the Errorf() format and arguments which are equivalent to wrapping with msg
+func wrapFormat(msg ast.Expr) (string, []ast.Expr) {
+	if lit, ok := stringLit(msg); ok {
+		return escape(lit, true) + ": %w", nil
+	}
+	return "%s: %w", []ast.Expr{msg}
+}
+
+// wrapfArgs returns the format and args of errors.Wrapf() for the message of pkg/errors.Wrap()
+func wrapfArgs(msg ast.Expr) []ast.Expr {
+	if lit, ok := stringLit(msg); ok {
+		return []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(escape(lit, true)), ValuePos: msg.Pos()}}
+	}
+	return []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: `"%s"`, ValuePos: msg.Pos()}, msg}
+}
+
+// escape escapes the verbs in s when s is a message rather than a format
+func escape(s string, msg bool) string {
+	if msg {
+		return strings.ReplaceAll(s, "%", "%%")
+	}
+	return s
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// pkgErrorsTypes are the types of pkg/errors which have an equivalent of the same name
+var pkgErrorsTypes = map[string]bool{
+	"Frame":      true,
+	"StackTrace": true,
+}
+
+var (
+	verbs = regexp.MustCompile(`%[-+# 0-9.\[\]*]*[a-zA-Z%]`)
+	// embedded matches a word followed by a separator and a simple verb at the end of the string
+	embedded = regexp.MustCompile(`(\w+)([ =:]\s*)('?)$`)
+)
+
+// extractAttrs moves values embedded in the format which are preceded by a word in attrs
+// into an errors.With() call. The format is returned unchanged if it contains verbs
+// which are not simple.
+func extractAttrs(format string, args []ast.Expr, attrs map[string]string, x ast.Expr) (string, []ast.Expr, ast.Expr) {
+	if len(attrs) == 0 {
+		return format, args, x
+	}
+	locs := verbs.FindAllStringIndex(format, -1)
+
+	var out strings.Builder
+	var remaining, with []ast.Expr
+	var last, n int
+	for _, loc := range locs {
+		verb := format[loc[0]:loc[1]]
+		if verb == "%%" {
+			continue
+		}
+		if len(verb) != 2 || n >= len(args) {
+			return format, args, x
+		}
+
+		prefix := format[last:loc[0]]
+		m := embedded.FindStringSubmatchIndex(prefix)
+		key, ok := "", false
+		if m != nil && verb != "%w" {
+			key, ok = attrs[prefix[m[2]:m[3]]]
+		}
+		if !ok {
+			out.WriteString(format[last:loc[1]])
+			remaining = append(remaining, args[n])
+			last, n = loc[1], n+1
+			continue
+		}
+
+		// Remove the separator, quote and verb leaving the word
+		out.WriteString(prefix[:m[3]])
+		last = loc[1]
+		if quote := prefix[m[6]:m[7]]; quote != "" && strings.HasPrefix(format[last:], quote) {
+			last += len(quote)
+		}
+		with = append(with, &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(key)}, args[n])
+		n++
+	}
+	if n != len(args) {
+		return format, args, x
+	}
+	out.WriteString(format[last:])
+	if len(with) == 0 {
+		return format, args, x
+	}
+	return out.String(), remaining, &ast.CallExpr{
+		Fun:  &ast.SelectorExpr{X: ast.NewIdent("errors"), Sel: ast.NewIdent("With")},
+		Args: with,
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestMigrate(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		attrs  map[string]string
+		issues []string
+	}{
+		{
+			name: "pkgerrors",
+			issues: []string{
+				"testdata/pkgerrors.input:11:12: Wrap has no equivalent\n\terrors.Wrap",
+			},
+			attrs: map[string]string{"user": "user.id"},
+		},
+		{
+			name:  "fmt",
+			attrs: map[string]string{"user": "user.id", "count": "count"},
+		},
+		{
+			name:  "stderrors",
+			attrs: map[string]string{"host": "server.address"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			in := filepath.Join("testdata", tt.name+".input")
+			src, err := os.ReadFile(in)
+			require.NoError(t, err)
+
+			r, err := migrate(token.NewFileSet(), in, src, Options{Attrs: tt.attrs})
+			require.NoError(t, err)
+			assert.True(t, r.Changed)
+
+			var issues []string
+			for _, i := range r.Issues {
+				issues = append(issues, i.String())
+			}
+			assert.Equal(t, tt.issues, issues)
+
+			golden := filepath.Join("testdata", tt.name+".golden")
+			if *update {
+				require.NoError(t, os.WriteFile(golden, r.Src, 0644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(r.Src))
+		})
+	}
+}
+
+func TestMigrateUnchanged(t *testing.T) {
+	src := []byte("package store\n\nimport \"strings\"\n\nvar s = strings.ToUpper(\"a\")\n")
+	r, err := migrate(token.NewFileSet(), "store.go", src, Options{})
+	require.NoError(t, err)
+	assert.False(t, r.Changed)
+	assert.Equal(t, src, r.Src)
+}
+
+func TestDiff(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+	b := "1\n2\n3\n4\nfive\n6\n7\n8\n9\n10\n"
+	assert.Equal(t, "--- f.go\n+++ f.go\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n", diff("f.go", []byte(a), []byte(b)))
+}
+
+func TestDiffLarge(t *testing.T) {
+	var a, b strings.Builder
+	for i := 1; i <= 100_000; i++ {
+		fmt.Fprintf(&a, "%d\n", i)
+		switch i {
+		case 10:
+			b.WriteString("ten\n")
+		case 90_000:
+		default:
+			fmt.Fprintf(&b, "%d\n", i)
+		}
+	}
+	assert.Equal(t, "--- f.go\n+++ f.go\n"+
+		"@@ -7,7 +7,7 @@\n 7\n 8\n 9\n-10\n+ten\n 11\n 12\n 13\n"+
+		"@@ -89997,7 +89997,6 @@\n 89997\n 89998\n 89999\n-90000\n 90001\n 90002\n 90003\n",
+		diff("f.go", []byte(a.String()), []byte(b.String())))
+}
+
+func TestRunAttrs(t *testing.T) {
+	err := run([]string{"-attrs", "user", t.TempDir()})
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "expected word=key"), err.Error())
+}
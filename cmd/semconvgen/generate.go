@@ -0,0 +1,327 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const wrapWidth = 100
+
+// acronyms are key segments which are written in upper case in constant names
+var acronyms = map[string]string{
+	"api":  "API",
+	"aws":  "AWS",
+	"cpu":  "CPU",
+	"db":   "DB",
+	"dns":  "DNS",
+	"gcp":  "GCP",
+	"grpc": "GRPC",
+	"http": "HTTP",
+	"id":   "ID",
+	"io":   "IO",
+	"ip":   "IP",
+	"json": "JSON",
+	"jvm":  "JVM",
+	"os":   "OS",
+	"rpc":  "RPC",
+	"sql":  "SQL",
+	"tcp":  "TCP",
+	"tls":  "TLS",
+	"ttl":  "TTL",
+	"udp":  "UDP",
+	"uid":  "UID",
+	"uri":  "URI",
+	"url":  "URL",
+	"uuid": "UUID",
+	"vm":   "VM",
+}
+
+// constName returns the constant name for the key, 'http.request.method' becomes 'OtelHTTPRequestMethod'
+func constName(key string) string {
+	var b strings.Builder
+	b.WriteString("Otel")
+	parts := strings.FieldsFunc(key, func(r rune) bool { return r == '.' || r == '_' || r == '-' })
+	for _, p := range parts {
+		if a, ok := acronyms[p]; ok {
+			b.WriteString(a)
+			continue
+		}
+		b.WriteString(strings.ToUpper(p[:1]) + p[1:])
+	}
+	return b.String()
+}
+
+// existingNames parses the previously generated file and returns the constant names keyed by
+// attribute key, such that names which differ from constName() are preserved.
+func existingNames(path string) (map[string]string, error) {
+	names := make(map[string]string)
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return names, nil
+		}
+		return nil, err
+	}
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, s := range gd.Specs {
+			vs := s.(*ast.ValueSpec)
+			for i, n := range vs.Names {
+				if i >= len(vs.Values) {
+					continue
+				}
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				v, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return nil, err
+				}
+				names[v] = n.Name
+			}
+		}
+	}
+	return names, nil
+}
+
+// resolve names the attributes, any existing constant is always included even when
+// it is deprecated or no longer found in the registry so regenerating never breaks the API.
+func resolve(registry map[string]Attribute, attrs []Attribute, existing map[string]string) ([]Attribute, []string) {
+	var warnings []string
+	seen := make(map[string]bool, len(attrs))
+	for i := range attrs {
+		seen[attrs[i].Key] = true
+	}
+	for key := range existing {
+		if seen[key] {
+			continue
+		}
+		a, ok := registry[key]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("attribute '%s' not found in registry; keeping '%s'", key, existing[key]))
+			a = Attribute{Key: key}
+		}
+		attrs = append(attrs, a)
+	}
+
+	for i := range attrs {
+		attrs[i].Name = constName(attrs[i].Key)
+		if name, ok := existing[attrs[i].Key]; ok {
+			attrs[i].Name = name
+		}
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
+	sort.Strings(warnings)
+	return attrs, warnings
+}
+
+var constTemplate = template.Must(template.New("const").Funcs(template.FuncMap{
+	"doc": doc,
+}).Parse(`// Code generated by semconvgen{{ with .Version }} from semantic conventions {{ . }}{{ end }}. DO NOT EDIT.
+
+// These are not tied to a particular semver and are intended to be used in packages which otherwise have no
+// dependency nor need for dependency upon otel libraries.
+
+package {{ .Package }}
+
+const (
+{{- range $i, $a := .Attrs }}
+{{- if $i }}
+{{ end }}
+{{ doc $a }}	{{ $a.Name }} = "{{ $a.Key }}"
+{{- end }}
+)
+`))
+
+// generate renders the attributes as a Go source file of constants
+func generate(pkg, version string, attrs []Attribute) ([]byte, error) {
+	var buf bytes.Buffer
+	err := constTemplate.Execute(&buf, struct {
+		Package string
+		Version string
+		Attrs   []Attribute
+	}{Package: pkg, Version: version, Attrs: attrs})
+	if err != nil {
+		return nil, err
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("while formatting generated source: %w", err)
+	}
+	return b, nil
+}
+
+// doc returns the doc comment for the attribute including the type, examples and stability
+func doc(a Attribute) string {
+	if a.Type == "" && a.Brief == "" {
+		return ""
+	}
+	var lines []string
+	if a.Brief != "" {
+		lines = append(lines, wrap(a.Brief)...)
+		lines = append(lines, "")
+	}
+	if a.Type != "" {
+		lines = append(lines, "Type: "+a.Type)
+	}
+	if len(a.Enum) != 0 {
+		lines = append(lines, wrap("Enum: "+strings.Join(a.Enum, ", "))...)
+	}
+	if len(a.Examples) != 0 {
+		lines = append(lines, wrap("Examples: "+strings.Join(a.Examples, ", "))...)
+	}
+	if a.Stability != "" {
+		lines = append(lines, "Stability: "+a.Stability)
+	}
+	if a.Deprecated != "" {
+		lines = append(lines, "", "Deprecated: "+a.Deprecated)
+	}
+
+	var b strings.Builder
+	for _, l := range lines {
+		b.WriteString(strings.TrimRight("\t// "+l, " ") + "\n")
+	}
+	return b.String()
+}
+
+// wrap splits s into lines which fit within wrapWidth when indented as a comment
+func wrap(s string) []string {
+	var lines []string
+	var line string
+	for _, w := range strings.Fields(s) {
+		if line != "" && len(line)+len(w)+1 > wrapWidth-4 {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += w
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Constructor is a typed function which returns an slog.Attr for an attribute
+type Constructor struct {
+	Name   string
+	Doc    string
+	Params string
+	Body   string
+}
+
+// constructors returns a typed constructor for each attribute with a known type. Attributes with
+// an 'address' and 'port' pair, such as 'server.address' and 'server.port', get a constructor
+// which accepts both, returning an slog group with an empty key which is inlined by slog.
+func constructors(attrs []Attribute) ([]Constructor, []string) {
+	var warnings []string
+	byKey := make(map[string]Attribute, len(attrs))
+	for _, a := range attrs {
+		byKey[a.Key] = a
+	}
+
+	var result []Constructor
+	for _, a := range attrs {
+		c := Constructor{Name: strings.TrimPrefix(a.Name, "Otel")}
+		if t, ok := strings.CutPrefix(a.Type, "template["); ok {
+			param, ctor, ok := valueType(strings.TrimSuffix(t, "]"))
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("attribute '%s' has unknown type '%s'; skipping constructor", a.Key, a.Type))
+				continue
+			}
+			c.Doc = fmt.Sprintf("returns the `%s.<key>` attribute, see %s", a.Key, a.Name)
+			c.Params = "key string, " + param
+			c.Body = fmt.Sprintf("%s(%s+\".\"+key, v)", ctor, a.Name)
+			result = append(result, c)
+			continue
+		}
+
+		param, ctor, ok := valueType(a.Type)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("attribute '%s' has unknown type '%s'; skipping constructor", a.Key, a.Type))
+			continue
+		}
+		if ns, ok := strings.CutSuffix(a.Key, ".address"); ok && a.Type == "string" {
+			if port, ok := byKey[ns+".port"]; ok && port.Type == "int" {
+				c.Doc = fmt.Sprintf("returns the %s and %s attributes", a.Name, port.Name)
+				c.Params = "address string, port int"
+				c.Body = fmt.Sprintf("slog.Group(\"\", slog.String(%s, address), slog.Int(%s, port))", a.Name, port.Name)
+				result = append(result, c)
+				continue
+			}
+		}
+		c.Doc = fmt.Sprintf("returns the %s attribute", a.Name)
+		c.Params = param
+		c.Body = fmt.Sprintf("%s(%s, v)", ctor, a.Name)
+		result = append(result, c)
+	}
+	return result, warnings
+}
+
+// valueType returns the parameter and slog constructor for the semconv type
+func valueType(t string) (string, string, bool) {
+	switch t {
+	case "string":
+		return "v string", "slog.String", true
+	case "int":
+		return "v int", "slog.Int", true
+	case "double":
+		return "v float64", "slog.Float64", true
+	case "boolean":
+		return "v bool", "slog.Bool", true
+	case "string[]":
+		return "v ...string", "slog.Any", true
+	case "int[]":
+		return "v ...int", "slog.Any", true
+	case "double[]":
+		return "v ...float64", "slog.Any", true
+	case "boolean[]":
+		return "v ...bool", "slog.Any", true
+	}
+	return "", "", false
+}
+
+var attrsTemplate = template.Must(template.New("attrs").Parse(`// Code generated by semconvgen{{ with .Version }} from semantic conventions {{ . }}{{ end }}. DO NOT EDIT.
+
+package {{ .Package }}
+
+import "log/slog"
+{{ range .Constructors }}
+// {{ .Name }} {{ .Doc }}
+func {{ .Name }}({{ .Params }}) slog.Attr {
+	return {{ .Body }}
+}
+{{ end }}`))
+
+// generateAttrs renders the typed constructors as a Go source file
+func generateAttrs(pkg, version string, ctors []Constructor) ([]byte, error) {
+	var buf bytes.Buffer
+	err := attrsTemplate.Execute(&buf, struct {
+		Package      string
+		Version      string
+		Constructors []Constructor
+	}{Package: pkg, Version: version, Constructors: ctors})
+	if err != nil {
+		return nil, err
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("while formatting generated source: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGenerate(t *testing.T) {
+	in, err := os.ReadFile("testdata/otel.go.input")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	out := filepath.Join(dir, "otel.go")
+	require.NoError(t, os.WriteFile(out, in, 0644))
+
+	err = run([]string{
+		"-registry", "testdata/registry",
+		"-out", out,
+		"-attrs-out", filepath.Join(dir, "otel_attrs.go"),
+		"-version", "v1.27.0",
+		"-allow", "http,db,rpc,server,user,user_agent",
+		"-deny", "db.cosmosdb",
+	})
+	require.NoError(t, err)
+
+	for _, name := range []string{"otel.go", "otel_attrs.go"} {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		golden := filepath.Join("testdata", name+".golden")
+		if *update {
+			require.NoError(t, os.WriteFile(golden, b, 0644))
+		}
+		expected, err := os.ReadFile(golden)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(b), name)
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	ctors, warnings := constructors([]Attribute{
+		{Key: "client.address", Name: "OtelClientAddress", Type: "string"},
+		{Key: "client.port", Name: "OtelClientPort", Type: "int"},
+		{Key: "url.domain", Name: "OtelURLDomain", Type: "string"},
+		{Key: "url.port", Name: "OtelURLPort", Type: "int"},
+		{Key: "db.query.parameter", Name: "OtelDBQueryParameter", Type: "template[string]"},
+		{Key: "user.id", Name: "OtelUserID"},
+	})
+	require.Len(t, ctors, 5)
+	assert.Equal(t, Constructor{
+		Name:   "ClientAddress",
+		Doc:    "returns the OtelClientAddress and OtelClientPort attributes",
+		Params: "address string, port int",
+		Body:   `slog.Group("", slog.String(OtelClientAddress, address), slog.Int(OtelClientPort, port))`,
+	}, ctors[0])
+	assert.Equal(t, "v string", ctors[2].Params)
+	assert.Equal(t, "key string, v string", ctors[4].Params)
+	assert.Equal(t, []string{"attribute 'user.id' has unknown type ''; skipping constructor"}, warnings)
+}
+
+func TestConstName(t *testing.T) {
+	for _, tt := range []struct {
+		key  string
+		name string
+	}{
+		{key: "http.request.method", name: "OtelHTTPRequestMethod"},
+		{key: "http.response.status_code", name: "OtelHTTPResponseStatusCode"},
+		{key: "messaging.message.conversation_id", name: "OtelMessagingMessageConversationID"},
+		{key: "rpc.grpc.status_code", name: "OtelRPCGRPCStatusCode"},
+		{key: "db.query.text", name: "OtelDBQueryText"},
+		{key: "user_agent.name", name: "OtelUserAgentName"},
+	} {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.name, constName(tt.key))
+		})
+	}
+}
+
+func TestExistingNames(t *testing.T) {
+	names, err := existingNames("../../otel.go")
+	require.NoError(t, err)
+	assert.Equal(t, "OtelCodeFilePath", names["code.filepath"])
+	assert.Equal(t, "OtelHTTPUserAgentName", names["user_agent.name"])
+
+	names, err = existingNames(filepath.Join(t.TempDir(), "otel.go"))
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
+
+func TestFilter(t *testing.T) {
+	attrs := map[string]Attribute{
+		"messaging.system":            {Key: "messaging.system"},
+		"messaging.kafka.message.key": {Key: "messaging.kafka.message.key"},
+		"messaging.kafkaesque":        {Key: "messaging.kafkaesque"},
+		"http.method":                 {Key: "http.method", Deprecated: "Replaced by `http.request.method`."},
+		"http.request.method":         {Key: "http.request.method"},
+		"db.system":                   {Key: "db.system"},
+	}
+
+	var keys []string
+	for _, a := range filter(attrs, []string{"messaging", "http"}, []string{"messaging.kafka"}) {
+		keys = append(keys, a.Key)
+	}
+	assert.Equal(t, []string{"http.request.method", "messaging.kafkaesque", "messaging.system"}, keys)
+	assert.Len(t, filter(attrs, nil, nil), 5)
+}
+
+func TestRunErrors(t *testing.T) {
+	assert.EqualError(t, run(nil), "-registry is required")
+	assert.ErrorContains(t, run([]string{"-registry", t.TempDir()}), "no attributes found in registry")
+}
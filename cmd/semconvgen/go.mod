@@ -0,0 +1,13 @@
+module github.com/kapetan-io/errors/cmd/semconvgen
+
+go 1.21.7
+
+require (
+	github.com/stretchr/testify v1.9.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+)
@@ -0,0 +1,89 @@
+// Command semconvgen generates the OpenTelemetry semantic convention constants in otel.go and
+// the typed attribute constructors in otel_attrs.go from the YAML attribute registry of
+// https://github.com/open-telemetry/semantic-conventions
+//
+//	git clone -b v1.27.0 https://github.com/open-telemetry/semantic-conventions
+//	SEMCONV_REGISTRY=$PWD/semantic-conventions/model go generate ./...
+//
+// It is a separate module so the errors package does not depend upon gopkg.in/yaml.v3. As it is
+// run from its own directory, SEMCONV_REGISTRY must be an absolute path.
+//
+// Constants which already exist in the output file keep their names, even when the attribute
+// is deprecated or removed from the registry, so upgrading semconv versions never breaks the API.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "semconvgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("semconvgen", flag.ContinueOnError)
+	registry := fs.String("registry", "", "path to the semantic conventions YAML registry directory")
+	out := fs.String("out", "otel.go", "path to the generated Go file")
+	attrsOut := fs.String("attrs-out", "", "path to the generated Go file of typed attribute constructors, skipped if empty")
+	pkg := fs.String("package", "errors", "package name of the generated Go file")
+	version := fs.String("version", "", "semantic conventions version noted in the generated file header")
+	allow := fs.String("allow", "", "comma separated list of namespaces to include, all if empty")
+	deny := fs.String("deny", "", "comma separated list of namespaces to exclude")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *registry == "" {
+		return fmt.Errorf("-registry is required")
+	}
+
+	attrs, err := loadRegistry(*registry)
+	if err != nil {
+		return err
+	}
+	existing, err := existingNames(*out)
+	if err != nil {
+		return fmt.Errorf("while reading '%s': %w", *out, err)
+	}
+
+	result, warnings := resolve(attrs, filter(attrs, split(*allow), split(*deny)), existing)
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "semconvgen: %s\n", w)
+	}
+
+	b, err := generate(*pkg, *version, result)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*out, b, 0644); err != nil {
+		return err
+	}
+
+	if *attrsOut == "" {
+		return nil
+	}
+	ctors, warnings := constructors(result)
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "semconvgen: %s\n", w)
+	}
+	b, err = generateAttrs(*pkg, *version, ctors)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*attrsOut, b, 0644)
+}
+
+func split(s string) []string {
+	var result []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
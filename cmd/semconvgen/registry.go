@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Attribute is a semantic convention attribute found in the registry
+type Attribute struct {
+	// Key is the fully qualified attribute key, for example 'http.request.method'
+	Key string
+	// Name is the Go constant name, for example 'OtelHTTPRequestMethod'
+	Name       string
+	Brief      string
+	Type       string
+	Enum       []string
+	Examples   []string
+	Stability  string
+	Deprecated string
+}
+
+type registryFile struct {
+	Groups []registryGroup `yaml:"groups"`
+}
+
+type registryGroup struct {
+	ID         string              `yaml:"id"`
+	Type       string              `yaml:"type"`
+	Prefix     string              `yaml:"prefix"`
+	Attributes []registryAttribute `yaml:"attributes"`
+}
+
+type registryAttribute struct {
+	ID         string       `yaml:"id"`
+	Type       registryType `yaml:"type"`
+	Brief      string       `yaml:"brief"`
+	Examples   yaml.Node    `yaml:"examples"`
+	Stability  string       `yaml:"stability"`
+	Deprecated yaml.Node    `yaml:"deprecated"`
+}
+
+// registryType is either a scalar type name like 'string[]' or an enum of members
+type registryType struct {
+	Name    string
+	Members []registryMember
+}
+
+type registryMember struct {
+	ID    string    `yaml:"id"`
+	Value yaml.Node `yaml:"value"`
+}
+
+func (t *registryType) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.ScalarNode {
+		t.Name = n.Value
+		return nil
+	}
+	var enum struct {
+		Members []registryMember `yaml:"members"`
+	}
+	if err := n.Decode(&enum); err != nil {
+		return err
+	}
+	t.Members = enum.Members
+	return nil
+}
+
+// loadRegistry walks dir and returns every attribute defined in the YAML files found
+// keyed by the fully qualified attribute key.
+func loadRegistry(dir string) (map[string]Attribute, error) {
+	attrs := make(map[string]Attribute)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || (filepath.Ext(path) != ".yaml" && filepath.Ext(path) != ".yml") {
+			return nil
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var f registryFile
+		if err := yaml.Unmarshal(b, &f); err != nil {
+			return fmt.Errorf("while parsing '%s': %w", path, err)
+		}
+		for _, g := range f.Groups {
+			for _, ra := range g.Attributes {
+				// Attributes which only 'ref' an attribute defined elsewhere have no id
+				if ra.ID == "" {
+					continue
+				}
+				a := newAttribute(g.Prefix, ra)
+				if _, ok := attrs[a.Key]; ok && !strings.HasPrefix(g.ID, "registry.") {
+					continue
+				}
+				attrs[a.Key] = a
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(attrs) == 0 {
+		return nil, fmt.Errorf("no attributes found in registry '%s'", dir)
+	}
+	return attrs, nil
+}
+
+func newAttribute(prefix string, ra registryAttribute) Attribute {
+	a := Attribute{
+		Key:       ra.ID,
+		Brief:     strings.Join(strings.Fields(ra.Brief), " "),
+		Type:      ra.Type.Name,
+		Stability: ra.Stability,
+		Examples:  examples(&ra.Examples),
+	}
+	if prefix != "" {
+		a.Key = prefix + "." + ra.ID
+	}
+
+	if len(ra.Type.Members) != 0 {
+		a.Type = "string"
+		for _, m := range ra.Type.Members {
+			if m.Value.Tag == "!!int" {
+				a.Type = "int"
+			}
+			a.Enum = append(a.Enum, m.Value.Value)
+		}
+	}
+
+	switch ra.Deprecated.Kind {
+	case yaml.ScalarNode:
+		a.Deprecated = strings.Join(strings.Fields(ra.Deprecated.Value), " ")
+	case yaml.MappingNode:
+		var d struct {
+			Reason    string `yaml:"reason"`
+			RenamedTo string `yaml:"renamed_to"`
+		}
+		_ = ra.Deprecated.Decode(&d)
+		a.Deprecated = "Deprecated"
+		if d.RenamedTo != "" {
+			a.Deprecated = fmt.Sprintf("Replaced by `%s`.", d.RenamedTo)
+		}
+	}
+	return a
+}
+
+// examples formats the examples node as a list of Go literals
+func examples(n *yaml.Node) []string {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		return []string{literal(n)}
+	case yaml.SequenceNode:
+		var result []string
+		for _, c := range n.Content {
+			result = append(result, literal(c))
+		}
+		return result
+	}
+	return nil
+}
+
+func literal(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.SequenceNode:
+		var items []string
+		for _, c := range n.Content {
+			items = append(items, literal(c))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case yaml.ScalarNode:
+		if n.Tag == "!!str" {
+			return fmt.Sprintf("%q", n.Value)
+		}
+		return n.Value
+	}
+	return ""
+}
+
+// filter returns the attributes which are within the allowed namespaces and not within
+// the denied namespaces sorted by key. Deprecated attributes are not included.
+func filter(attrs map[string]Attribute, allow, deny []string) []Attribute {
+	var result []Attribute
+	for _, a := range attrs {
+		if a.Deprecated != "" {
+			continue
+		}
+		if len(allow) != 0 && !inNamespace(a.Key, allow) {
+			continue
+		}
+		if inNamespace(a.Key, deny) {
+			continue
+		}
+		result = append(result, a)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// inNamespace returns true if the key is within one of the namespaces, such that
+// 'messaging.kafka' includes 'messaging.kafka.message.key' but not 'messaging.kafkaesque'
+func inNamespace(key string, namespaces []string) bool {
+	for _, ns := range namespaces {
+		if key == ns || strings.HasPrefix(key, ns+".") {
+			return true
+		}
+	}
+	return false
+}
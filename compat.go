@@ -0,0 +1,283 @@
+package errors
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// The functions and types in this file provide the same shapes as github.com/pkg/errors
+// such that consumers which inspect errors via `interface{ Cause() error }` or
+// `interface{ StackTrace() errors.StackTrace }` continue to work when this package
+// replaces pkg/errors.
+
+// Frame represents a program counter inside a stack frame. For historical reasons
+// if Frame is interpreted as a uintptr its value represents the program counter + 1,
+// which is the same as the values returned by runtime.Callers() and pkg/errors.Frame
+type Frame uintptr
+
+// frame returns the runtime.Frame for this Frame
+func (f Frame) frame() runtime.Frame {
+	frame, _ := runtime.CallersFrames([]uintptr{uintptr(f)}).Next()
+	return frame
+}
+
+// name returns the function name without the package path
+func (f Frame) name() string {
+	name := f.frame().Function
+	if name == "" {
+		return "unknown"
+	}
+	if i := strings.LastIndex(name, "/"); i != -1 {
+		name = name[i+1:]
+	}
+	if i := strings.Index(name, "."); i != -1 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// Format formats the frame according to the fmt.Formatter interface in the
+// same way as pkg/errors.
+//
+//	%s    source file
+//	%d    source line
+//	%n    function name
+//	%v    equivalent to %s:%d
+//
+// Format accepts flags that alter the printing of some verbs, as follows:
+//
+//	%+s   function name and path of source file relative to the compile time
+//	      GOPATH separated by \n\t (<funcname>\n\t<path>)
+//	%+v   equivalent to %+s:%d
+func (f Frame) Format(s fmt.State, verb rune) {
+	frame := f.frame()
+	switch verb {
+	case 's':
+		switch {
+		case frame.Function == "":
+			_, _ = io.WriteString(s, "unknown")
+		case s.Flag('+'):
+			_, _ = io.WriteString(s, frame.Function+"\n\t"+frame.File)
+		default:
+			_, _ = io.WriteString(s, path.Base(frame.File))
+		}
+	case 'd':
+		_, _ = io.WriteString(s, strconv.Itoa(frame.Line))
+	case 'n':
+		_, _ = io.WriteString(s, f.name())
+	case 'v':
+		f.Format(s, 's')
+		_, _ = io.WriteString(s, ":")
+		f.Format(s, 'd')
+	}
+}
+
+// MarshalText formats a stacktrace Frame as a text string. The output is the
+// same as that of fmt.Sprintf("%+v", f), but without newlines or tabs.
+func (f Frame) MarshalText() ([]byte, error) {
+	frame := f.frame()
+	if frame.Function == "" {
+		return []byte("unknown"), nil
+	}
+	return []byte(fmt.Sprintf("%s %s:%d", frame.Function, frame.File, frame.Line)), nil
+}
+
+// StackTrace is a stack of Frames from innermost (newest) to outermost (oldest).
+type StackTrace []Frame
+
+// Format formats the stack of Frames according to the fmt.Formatter interface
+// in the same way as pkg/errors.
+//
+//	%s	lists source files for each Frame in the stack
+//	%v	lists the source file and line number for each Frame in the stack
+//
+// Format accepts flags that alter the printing of some verbs, as follows:
+//
+//	%+v   Prints filename, function, and line number for each Frame in the stack.
+func (st StackTrace) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case s.Flag('+'):
+			for _, f := range st {
+				_, _ = io.WriteString(s, "\n")
+				f.Format(s, verb)
+			}
+		case s.Flag('#'):
+			_, _ = fmt.Fprintf(s, "%#v", []Frame(st))
+		default:
+			st.formatSlice(s, verb)
+		}
+	case 's':
+		st.formatSlice(s, verb)
+	}
+}
+
+// formatSlice formats the stack as a slice of Frames
+func (st StackTrace) formatSlice(s fmt.State, verb rune) {
+	_, _ = io.WriteString(s, "[")
+	for i, f := range st {
+		if i > 0 {
+			_, _ = io.WriteString(s, " ")
+		}
+		f.Format(s, verb)
+	}
+	_, _ = io.WriteString(s, "]")
+}
+
+// Cause returns the underlying cause of the error, if possible. An error value has
+// a cause if it implements `interface{ Cause() error }`, which includes ErrAttrs.
+//
+// If the error does not implement Cause, the original error will be returned.
+// If the error is nil, nil will be returned without further investigation.
+func Cause(err error) error {
+	type causer interface {
+		Cause() error
+	}
+	for err != nil {
+		c, ok := err.(causer)
+		if !ok {
+			break
+		}
+		cause := c.Cause()
+		if cause == nil {
+			break
+		}
+		err = cause
+	}
+	return err
+}
+
+// Cause returns the error passed to the function which created this error, such as the err
+// of Wrap(), WithStack(), Wrapf(), WithMessage() or the error wrapped by `%w` in Errorf().
+// Returns nil if no error was passed, such that errors.Cause() and pkg/errors.Cause() stop
+// at this error and a sentinel created with Error() is its own cause.
+func (e *ErrAttrs) Cause() error {
+	if e.wrap {
+		return e.wrapped
+	}
+	if u, ok := e.wrapped.(interface{ Unwrap() error }); ok {
+		return u.Unwrap()
+	}
+	return nil
+}
+
+// StackTrace returns the stack captured by the ErrAttrs closest to the root of
+// the err tree in the same form as pkg/errors. If no stack was captured, the
+// code location closest to the root of the err tree is returned as the only Frame.
+// Returns nil for errors decoded by UnmarshalJSON()
+func (e *ErrAttrs) StackTrace() StackTrace {
+	pcs := e.Stack()
+	if pcs == nil {
+		if _, pc := e.Attrs(); pc != 0 {
+			pcs = []uintptr{pc}
+		}
+	}
+	if len(pcs) == 0 {
+		return nil
+	}
+	st := make(StackTrace, len(pcs))
+	for i, pc := range pcs {
+		st[i] = Frame(pc)
+	}
+	return st
+}
+
+// WithStack works like pkg/errors.WithStack() and returns an error which captures
+// the full call stack at the point WithStack is called, regardless of SetCaptureStack().
+// If err is nil, WithStack returns nil.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+	var pcs [1]uintptr
+	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	a := &Attrs{stack: true}
+	return &ErrAttrs{
+		attrs:   a,
+		pc:      pcs[0],
+		stack:   a.callers(),
+		wrapped: err,
+		wrap:    true,
+	}
+}
+
+// WithMessage works like pkg/errors.WithMessage() and annotates err with a new
+// message and the code location where WithMessage is called. Attributes in
+// the err tree are preserved. If err is nil, WithMessage returns nil.
+//
+//	return errors.WithMessage(err, "while fetching user")
+func WithMessage(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	var pcs [1]uintptr
+	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	a := &Attrs{}
+	return &ErrAttrs{
+		wrapped: fmt.Errorf("%s: %w", msg, err),
+		attrs:   a,
+		pc:      pcs[0],
+		stack:   a.callers(),
+	}
+}
+
+// WithMessagef works like pkg/errors.WithMessagef() and annotates err with the
+// format specifier and the code location where WithMessagef is called. Attributes
+// in the err tree are preserved. If err is nil, WithMessagef returns nil.
+func WithMessagef(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	var pcs [1]uintptr
+	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	a := &Attrs{}
+	return &ErrAttrs{
+		wrapped: fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err),
+		attrs:   a,
+		pc:      pcs[0],
+		stack:   a.callers(),
+	}
+}
+
+// Wrapf works like pkg/errors.Wrapf() and annotates err with the format specifier
+// and the full call stack at the point Wrapf is called, regardless of SetCaptureStack().
+// Attributes in the err tree are preserved. If err is nil, Wrapf returns nil.
+//
+//	return errors.Wrapf(err, "while fetching user '%s'", id)
+func Wrapf(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	var pcs [1]uintptr
+	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	a := &Attrs{stack: true}
+	return &ErrAttrs{
+		wrapped: fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err),
+		attrs:   a,
+		pc:      pcs[0],
+		stack:   a.callers(),
+	}
+}
+
+// Wrapf annotates err with the format specifier, the attributes of this *Attrs
+// and the code location where Wrapf is called. If err is nil, Wrapf returns nil.
+//
+//	return errors.With("user.id", id).Wrapf(err, "while fetching user")
+func (a *Attrs) Wrapf(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	var pcs [1]uintptr
+	runtime.Callers(2, pcs[:]) // skip [runtime.Callers, and this function]
+	return &ErrAttrs{
+		wrapped: fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err),
+		pc:      pcs[0],
+		stack:   a.callers(),
+		attrs:   a,
+	}
+}
@@ -0,0 +1,148 @@
+package errors_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type causer interface {
+	Cause() error
+}
+
+type stackTracer interface {
+	StackTrace() errors.StackTrace
+}
+
+func TestCause(t *testing.T) {
+	err := errors.With("foo", "bar").Errorf("query failed: %w", io.EOF)
+	err = errors.Wrap(err)
+	err = errors.WithMessage(err, "while fetching user")
+
+	var c causer
+	require.True(t, errors.As(err, &c))
+	assert.Equal(t, io.EOF, errors.Cause(err))
+	assert.Equal(t, io.EOF, errors.Cause(io.EOF))
+	assert.Nil(t, errors.Cause(nil))
+
+	t.Run("Error", func(t *testing.T) {
+		err := errors.Error("query failed")
+		assert.Equal(t, err, errors.Cause(err))
+		var e *errors.ErrAttrs
+		assert.True(t, errors.As(errors.Cause(err), &e))
+	})
+}
+
+func TestStackTrace(t *testing.T) {
+	err := errors.WithStack(io.EOF)
+	require.NotNil(t, err)
+	assert.Nil(t, errors.WithStack(nil))
+	assert.Equal(t, "EOF", err.Error())
+	assert.True(t, errors.Is(err, io.EOF))
+
+	var st stackTracer
+	require.True(t, errors.As(err, &st))
+	trace := st.StackTrace()
+	require.NotEmpty(t, trace)
+
+	t.Run("Frame", func(t *testing.T) {
+		assert.Equal(t, "compat_test.go", fmt.Sprintf("%s", trace[0]))
+		assert.Equal(t, "42", fmt.Sprintf("%d", trace[0]))
+		assert.Equal(t, "TestStackTrace", fmt.Sprintf("%n", trace[0]))
+		assert.Equal(t, "compat_test.go:42", fmt.Sprintf("%v", trace[0]))
+		out := fmt.Sprintf("%+v", trace[0])
+		assert.True(t, strings.HasPrefix(out, "github.com/kapetan-io/errors_test.TestStackTrace\n\t"))
+		assert.True(t, strings.HasSuffix(out, "compat_test.go:42"))
+
+		text, err := trace[0].MarshalText()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(text), "github.com/kapetan-io/errors_test.TestStackTrace "))
+	})
+
+	t.Run("StackTrace", func(t *testing.T) {
+		out := fmt.Sprintf("%+v", trace)
+		assert.True(t, strings.HasPrefix(out, "\ngithub.com/kapetan-io/errors_test.TestStackTrace\n\t"))
+		assert.Contains(t, out, "\ntesting.tRunner\n\t")
+		assert.True(t, strings.HasPrefix(fmt.Sprintf("%v", trace), "[compat_test.go:42 testing.go:"))
+		assert.True(t, strings.HasPrefix(fmt.Sprintf("%s", trace), "[compat_test.go testing.go"))
+	})
+
+	t.Run("CodeLocation", func(t *testing.T) {
+		err := errors.Wrap(errors.Error("query failed"))
+		require.True(t, errors.As(err, &st))
+		trace := st.StackTrace()
+		require.Len(t, trace, 1)
+		assert.Equal(t, "compat_test.go:76", fmt.Sprintf("%v", trace[0]))
+	})
+
+	t.Run("UnmarshalJSON", func(t *testing.T) {
+		var e errors.ErrAttrs
+		require.NoError(t, e.UnmarshalJSON([]byte(`{"msg":"query failed"}`)))
+		assert.Nil(t, e.StackTrace())
+	})
+}
+
+func TestWithMessage(t *testing.T) {
+	err := errors.With("foo", "bar").Error("query failed")
+	assert.Nil(t, errors.WithMessage(nil, "while fetching user"))
+	assert.Nil(t, errors.WithMessagef(nil, "while fetching user '%s'", "thrawn"))
+
+	wrap := errors.WithMessage(err, "while fetching user")
+	assert.Equal(t, "while fetching user: query failed", wrap.Error())
+	assert.True(t, errors.Is(wrap, err))
+	assert.Equal(t, "bar", findAttr(errors.AttrsFrom(wrap), "foo").Value.String())
+
+	wrap = errors.WithMessagef(err, "while fetching user '%s'", "thrawn")
+	assert.Equal(t, "while fetching user 'thrawn': query failed", wrap.Error())
+	assert.Equal(t, "bar", findAttr(errors.AttrsFrom(wrap), "foo").Value.String())
+
+	t.Run("CodeLocation", func(t *testing.T) {
+		wrap := errors.WithMessage(io.EOF, "while fetching user")
+		attr := findAttr(errors.AttrsFromWithCodeLoc(wrap), errors.OtelCodeFunction)
+		require.NotNil(t, attr)
+		assert.Equal(t, "github.com/kapetan-io/errors_test.TestWithMessage.func1", attr.Value.String())
+	})
+}
+
+func TestWrapf(t *testing.T) {
+	err := errors.With("foo", "bar").Error("query failed")
+	assert.Nil(t, errors.Wrapf(nil, "while fetching user"))
+	assert.Nil(t, errors.With("user.id", "thrawn").Wrapf(nil, "while fetching user"))
+
+	wrap := errors.Wrapf(err, "while fetching user '%s'", "thrawn")
+	assert.Equal(t, "while fetching user 'thrawn': query failed", wrap.Error())
+	assert.True(t, errors.Is(wrap, err))
+	assert.Equal(t, "bar", findAttr(errors.AttrsFrom(wrap), "foo").Value.String())
+	assert.NotNil(t, findAttr(errors.AttrsFromWithCodeLoc(wrap), errors.OtelExceptionStacktrace))
+
+	var st stackTracer
+	require.True(t, errors.As(wrap, &st))
+	assert.True(t, len(st.StackTrace()) > 1)
+
+	t.Run("Attrs", func(t *testing.T) {
+		wrap := errors.With("user.id", "thrawn").Wrapf(err, "while fetching user")
+		assert.Equal(t, "while fetching user: query failed", wrap.Error())
+		attrs := errors.AttrsFrom(wrap)
+		assert.Equal(t, "thrawn", findAttr(attrs, "user.id").Value.String())
+		assert.Equal(t, "bar", findAttr(attrs, "foo").Value.String())
+	})
+}
+
+func TestCauseSentinel(t *testing.T) {
+	ErrNotFound := errors.Error("not found")
+	assert.Equal(t, ErrNotFound, errors.Cause(ErrNotFound))
+	assert.Equal(t, ErrNotFound, errors.Cause(errors.Wrapf(ErrNotFound, "while fetching user")))
+	assert.Equal(t, ErrNotFound, errors.Cause(errors.WithMessage(errors.WithStack(ErrNotFound), "while fetching user")))
+	assert.Equal(t, ErrNotFound, errors.Cause(errors.Errorf("while fetching user: %w", ErrNotFound)))
+	assert.Equal(t, ErrNotFound, errors.Cause(errors.With("foo", "bar").Wrap(ErrNotFound)))
+
+	err := errors.With("foo", "bar").Errorf("query failed: %d", 1)
+	assert.Equal(t, err, errors.Cause(err))
+	err = errors.With("foo", "bar").Join(ErrNotFound, io.EOF)
+	assert.Equal(t, err, errors.Cause(err))
+}
@@ -0,0 +1,36 @@
+package errors
+
+import (
+	"context"
+	"log/slog"
+)
+
+type ctxKey struct{}
+
+// ContextWith returns a copy of ctx which includes the given attributes combined
+// with any attributes already attached to ctx. The attributes can be retrieved
+// with FromContext() to attach request scoped values to errors.
+//
+//	ctx = errors.ContextWith(ctx, errors.OtelUserID, user.ID, errors.OtelSessionID, session.ID)
+//
+//	// Includes user.id and session.id attributes
+//	return errors.FromContext(ctx).With("foo", "bar").Errorf("query failed: %w", err)
+func ContextWith(ctx context.Context, args ...any) context.Context {
+	return context.WithValue(ctx, ctxKey{}, FromContext(ctx).With(args...))
+}
+
+// ContextWithAttr returns a copy of ctx which includes the given slog.Attr combined
+// with any attributes already attached to ctx. See ContextWith()
+func ContextWithAttr(ctx context.Context, attrs ...slog.Attr) context.Context {
+	return context.WithValue(ctx, ctxKey{}, FromContext(ctx).WithAttr(attrs...))
+}
+
+// FromContext returns an *Attrs which includes all the attributes attached to ctx
+// via ContextWith() or ContextWithAttr(). If ctx has no attributes attached an
+// empty *Attrs is returned, as such it is always safe to call methods on the result.
+func FromContext(ctx context.Context) *Attrs {
+	if a, ok := ctx.Value(ctxKey{}).(*Attrs); ok {
+		return a
+	}
+	return &Attrs{}
+}
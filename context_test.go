@@ -0,0 +1,38 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	ctx := errors.ContextWith(context.Background(), errors.OtelUserID, "user-1")
+	ctx = errors.ContextWithAttr(ctx, slog.String(errors.OtelSessionID, "session-1"))
+
+	t.Run("InheritsAttrs", func(t *testing.T) {
+		err := errors.FromContext(ctx).With("foo", "bar").Errorf("query failed: %w", errors.New("timeout"))
+		assert.Equal(t, "query failed: timeout (user.id=user-1, session.id=session-1, foo=bar)", fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("DoesNotModifyParent", func(t *testing.T) {
+		child := errors.ContextWith(ctx, "request.id", "req-1")
+		other := errors.ContextWith(ctx, "request.id", "req-2")
+
+		assert.Equal(t, "[user.id=user-1 session.id=session-1 request.id=req-1]",
+			fmt.Sprintf("%v", errors.AttrsFrom(errors.FromContext(child).Error("error"))))
+		assert.Equal(t, "[user.id=user-1 session.id=session-1 request.id=req-2]",
+			fmt.Sprintf("%v", errors.AttrsFrom(errors.FromContext(other).Error("error"))))
+		assert.Equal(t, "[user.id=user-1 session.id=session-1]",
+			fmt.Sprintf("%v", errors.AttrsFrom(errors.FromContext(ctx).Error("error"))))
+	})
+
+	t.Run("EmptyContext", func(t *testing.T) {
+		err := errors.FromContext(context.Background()).Error("error")
+		assert.Equal(t, "error ()", fmt.Sprintf("%+v", err))
+	})
+}
@@ -1,5 +1,10 @@
 package errors
 
+// Regenerate the OTEL semantic convention constants in otel.go from a local checkout of
+// https://github.com/open-telemetry/semantic-conventions, see cmd/semconvgen which is a
+// separate module so this package does not depend upon gopkg.in/yaml.v3
+//go:generate go run -C cmd/semconvgen . -registry $SEMCONV_REGISTRY -version v1.27.0 -out ../../otel.go -attrs-out ../../otel_attrs.go -allow client,code,db,error,exception,file,host,http,messaging,network,rpc,server,service,session,tls,url,user,user_agent -deny db.cassandra,db.cosmosdb,db.elasticsearch,messaging.eventhubs,messaging.gcp_pubsub,messaging.kafka,messaging.rabbitmq,messaging.rocketmq,messaging.servicebus
+
 import (
 	"errors"
 	"reflect"
@@ -30,6 +35,50 @@ func New(text string) error {
 	return errors.New(text)
 }
 
+// Join returns an error that wraps the given errors.
+// Any nil error values are discarded.
+// Join returns nil if every value in errs is nil.
+// The error formats as the concatenation of the strings obtained
+// by calling the Error method of each element of errs, with a newline
+// between each string.
+//
+// To attach attributes and code location to the joined errors use
+// errors.With().Join()
+func Join(errs ...error) error {
+	return errors.Join(errs...)
+}
+
+// ErrUnsupported indicates that a requested operation cannot be performed,
+// because it is unsupported. See the standard lib `errors.ErrUnsupported`
+var ErrUnsupported = errors.ErrUnsupported
+
+// joinErrs is the error wrapped by ErrAttrs when created by Attrs.Join()
+// which allows ErrAttrs to format each of the joined errors.
+type joinErrs struct {
+	errs []error
+}
+
+func newJoinErrs(errs []error) *joinErrs {
+	j := &joinErrs{}
+	for _, err := range errs {
+		if err != nil {
+			j.errs = append(j.errs, err)
+		}
+	}
+	if len(j.errs) == 0 {
+		return nil
+	}
+	return j
+}
+
+func (j *joinErrs) Error() string {
+	return errors.Join(j.errs...).Error()
+}
+
+func (j *joinErrs) Unwrap() []error {
+	return j.errs
+}
+
 // Unwrap returns the result of calling the Unwrap method on err, if err's
 // type contains an Unwrap method returning error.
 // Otherwise, Unwrap returns nil.
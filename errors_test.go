@@ -1,11 +1,15 @@
 package errors_test
 
 import (
+	stderrors "errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"testing"
 
 	"github.com/kapetan-io/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLast(t *testing.T) {
@@ -61,3 +65,76 @@ func TestWrap(t *testing.T) {
 	assert.True(t, pc != 0)
 	assert.Equal(t, 0, len(as))
 }
+
+func TestAttrsFromJoin(t *testing.T) {
+	one := errors.With("foo", "bar").Error("one")
+	two := errors.With("foo", "baz").Error("two")
+
+	t.Run("Join", func(t *testing.T) {
+		err := fmt.Errorf("fan out: %w", stderrors.Join(one, errors.New("no attrs"), two))
+		as := errors.AttrsFrom(err)
+		require.Len(t, as, 2)
+		assert.Equal(t, "[0=[foo=bar] 2=[foo=baz]]", fmt.Sprintf("%v", as))
+	})
+
+	t.Run("MultipleWrapVerbs", func(t *testing.T) {
+		err := errors.With("key", "value").Errorf("first: %w, second: %w", one, two)
+		as, pc := err.(errors.HasAttrs).Attrs()
+		assert.Equal(t, "[key=value 0=[foo=bar] 1=[foo=baz]]", fmt.Sprintf("%v", as))
+		onePC := one.(*errors.ErrAttrs)
+		_, expected := onePC.Attrs()
+		assert.Equal(t, expected, pc)
+		assert.Equal(t, "first: one, second: two (key=value, 0=[foo=bar], 1=[foo=baz])", fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("NestedJoin", func(t *testing.T) {
+		err := stderrors.Join(stderrors.Join(errors.New("no attrs"), one), two)
+		assert.Equal(t, "[0=[1=[foo=bar]] 1=[foo=baz]]", fmt.Sprintf("%v", errors.AttrsFrom(err)))
+	})
+
+	t.Run("NoAttrs", func(t *testing.T) {
+		err := stderrors.Join(errors.New("one"), errors.New("two"))
+		assert.Equal(t, []slog.Attr{slog.Any("", nil)}, errors.AttrsFrom(err))
+	})
+}
+
+func TestJoin(t *testing.T) {
+	one := errors.With("foo", "bar").Error("one")
+	two := errors.With("foo", "baz").Error("two")
+
+	t.Run("PassThrough", func(t *testing.T) {
+		err := errors.Join(one, nil, two)
+		assert.EqualError(t, err, "one\ntwo")
+		assert.True(t, errors.Is(err, one))
+		assert.True(t, errors.Is(err, two))
+		assert.Nil(t, errors.Join(nil, nil))
+		assert.True(t, errors.Is(fmt.Errorf("op: %w", errors.ErrUnsupported), stderrors.ErrUnsupported))
+	})
+
+	t.Run("WithAttrs", func(t *testing.T) {
+		err := errors.With("job", "fan-out").Join(one, nil, errors.New("three"), two)
+		assert.EqualError(t, err, "one\nthree\ntwo")
+		assert.True(t, errors.Is(err, one))
+		assert.True(t, errors.Is(err, two))
+
+		assert.Equal(t, "[job=fan-out 0=[foo=bar] 2=[foo=baz]]", fmt.Sprintf("%v", errors.AttrsFrom(err)))
+		assert.Nil(t, errors.With("job", "fan-out").Join(nil))
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		err := errors.With("job", "fan-out").Join(one, errors.New("three"), two)
+		lines := strings.Split(fmt.Sprintf("%+v", err), "\n")
+		require.Len(t, lines, 4)
+		assert.Equal(t, "3 errors (job=fan-out)", lines[0])
+		assert.Regexp(t, `^\[0\] one \(foo=bar\) at github.com/kapetan-io/errors_test.TestJoin .*errors_test.go:\d+$`, lines[1])
+		assert.Equal(t, "[1] three", lines[2])
+		assert.Regexp(t, `^\[2\] two \(foo=baz\) at github.com/kapetan-io/errors_test.TestJoin .*errors_test.go:\d+$`, lines[3])
+	})
+}
+
+func TestWrapUnwrap(t *testing.T) {
+	inner := fmt.Errorf("inner: %w", errors.New("root"))
+	err := errors.With("key", "value").Wrap(inner)
+	assert.Equal(t, inner, errors.Unwrap(err))
+	assert.Equal(t, inner, errors.Unwrap(errors.Wrap(inner)))
+}
@@ -0,0 +1,212 @@
+// Package errorstest provides test assertions for errors created by the errors package,
+// which match attributes by key and code locations by function and file name rather than
+// line numbers, such that tests do not break when unrelated code moves.
+//
+//	func TestFetchUser(t *testing.T) {
+//		err := FetchUser(ctx, "user-1")
+//		errorstest.KindIs(t, err, errors.NotFound)
+//		errorstest.HasAttr(t, err, errors.OtelUserID, "user-1")
+//		errorstest.CreatedAt(t, err, "FetchUser", "user.go")
+//	}
+package errorstest
+
+import (
+	"fmt"
+	"go/build"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kapetan-io/errors"
+)
+
+// TestingT is the subset of testing.TB used by the assertions
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// UpdateEnv is the environment variable which when set to '1' causes Snapshot()
+// to write the golden file instead of comparing against it.
+const UpdateEnv = "ERRORSTEST_UPDATE"
+
+// HasAttr asserts the err tree has an attribute with the key and value. Attributes within a
+// group are matched by the key of the group joined with the key of the attribute by '.'
+// Values are compared after conversion to a slog.Value, such that `HasAttr(t, err, "count", 1)`
+// matches an attribute created with `errors.With("count", int64(1))`
+func HasAttr(t TestingT, err error, key string, value any) bool {
+	t.Helper()
+	attrs := flatten(errors.AttrsFrom(err))
+	v, ok := attrs[key]
+	if !ok {
+		t.Errorf("error '%v' has no attribute '%s'; has %s", err, key, keys(attrs))
+		return false
+	}
+	if !equal(v, value) {
+		t.Errorf("error '%v' attribute '%s' is '%v'; expected '%v'", err, key, v, value)
+		return false
+	}
+	return true
+}
+
+// AttrsEqual asserts the attributes of the err tree are exactly the expected attributes,
+// attributes within a group are matched as described by HasAttr()
+func AttrsEqual(t TestingT, err error, expected map[string]any) bool {
+	t.Helper()
+	attrs := flatten(errors.AttrsFrom(err))
+	var diff []string
+	for k, v := range expected {
+		actual, ok := attrs[k]
+		switch {
+		case !ok:
+			diff = append(diff, fmt.Sprintf("missing '%s'", k))
+		case !equal(actual, v):
+			diff = append(diff, fmt.Sprintf("'%s' is '%v'; expected '%v'", k, actual, v))
+		}
+	}
+	for k := range attrs {
+		if _, ok := expected[k]; !ok {
+			diff = append(diff, fmt.Sprintf("unexpected '%s'", k))
+		}
+	}
+	if len(diff) != 0 {
+		sort.Strings(diff)
+		t.Errorf("error '%v' attributes do not match; %s", err, strings.Join(diff, ", "))
+		return false
+	}
+	return true
+}
+
+// CreatedAt asserts the code location of the err tree, as returned by errors.AttrsFromWithCodeLoc(),
+// is within the function and file. The function may be the fully qualified function name or
+// the name without the package such as 'FetchUser' or '(*Client).FetchUser'. The file may be
+// the path or base name of the file.
+func CreatedAt(t TestingT, err error, fn, file string) bool {
+	t.Helper()
+	attrs := flatten(errors.AttrsFromWithCodeLoc(err))
+	function, _ := attrs[errors.OtelCodeFunction].(string)
+	path, _ := attrs[errors.OtelCodeFilePath].(string)
+	if function == "" {
+		t.Errorf("error '%v' has no code location", err)
+		return false
+	}
+
+	if function != fn && !strings.HasSuffix(function, "."+fn) {
+		t.Errorf("error '%v' was created in function '%s'; expected '%s'", err, function, fn)
+		return false
+	}
+	if path != file && !strings.HasSuffix(path, "/"+file) {
+		t.Errorf("error '%v' was created in file '%s'; expected '%s'", err, path, file)
+		return false
+	}
+	return true
+}
+
+// KindIs asserts the Kind of the err tree, as returned by errors.KindOf(), is kind
+func KindIs(t TestingT, err error, kind errors.Kind) bool {
+	t.Helper()
+	if k := errors.KindOf(err); k != kind {
+		t.Errorf("error '%v' is of kind '%s'; expected '%s'", err, k, kind)
+		return false
+	}
+	return true
+}
+
+var goPath = regexp.MustCompile(`(\S*/)?([^/\s]+\.(?:go|s)):\d+`)
+
+// Normalize returns the `%+v` output of err with file paths replaced by the base name of
+// the file without the line number and the stack frames of the standard library removed,
+// such that the output does not change across machines, Go versions or when unrelated
+// code moves.
+func Normalize(err error) string {
+	goroot := filepath.ToSlash(build.Default.GOROOT) + "/"
+	lines := strings.Split(fmt.Sprintf("%+v", err), "\n")
+	result := make([]string, 0, len(lines))
+	for _, l := range lines {
+		// Stack frames are formatted as 'function\n\tfile:line'
+		if strings.HasPrefix(l, "\t") && strings.HasPrefix(strings.TrimPrefix(l, "\t"), goroot) {
+			if len(result) != 0 {
+				result = result[:len(result)-1]
+			}
+			continue
+		}
+		result = append(result, goPath.ReplaceAllString(l, "$2"))
+	}
+	return strings.Join(result, "\n")
+}
+
+// Snapshot asserts the Normalize() output of err matches the contents of the golden file.
+// If the environment variable UpdateEnv is '1' the golden file is written instead.
+//
+//	ERRORSTEST_UPDATE=1 go test ./...
+func Snapshot(t TestingT, err error, golden string) bool {
+	t.Helper()
+	actual := Normalize(err)
+	if os.Getenv(UpdateEnv) == "1" {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Errorf("while creating golden file directory: %s", err)
+			return false
+		}
+		if err := os.WriteFile(golden, []byte(actual), 0644); err != nil {
+			t.Errorf("while writing golden file: %s", err)
+			return false
+		}
+		return true
+	}
+
+	b, rerr := os.ReadFile(golden)
+	if rerr != nil {
+		t.Errorf("while reading golden file: %s; run with %s=1 to create it", rerr, UpdateEnv)
+		return false
+	}
+	if string(b) != actual {
+		t.Errorf("error does not match golden file '%s'\nexpected:\n%s\nactual:\n%s", golden, string(b), actual)
+		return false
+	}
+	return true
+}
+
+// flatten returns the attributes keyed by the attribute key, attributes
+// within a group are keyed by the key of the group joined by '.'
+func flatten(attrs []slog.Attr) map[string]any {
+	result := make(map[string]any, len(attrs))
+	var walk func(prefix string, attrs []slog.Attr)
+	walk = func(prefix string, attrs []slog.Attr) {
+		for _, a := range attrs {
+			if a.Key == "" {
+				continue
+			}
+			key := a.Key
+			if prefix != "" {
+				key = prefix + "." + a.Key
+			}
+			v := a.Value.Resolve()
+			if v.Kind() == slog.KindGroup {
+				walk(key, v.Group())
+				continue
+			}
+			result[key] = v.Any()
+		}
+	}
+	walk("", attrs)
+	return result
+}
+
+// equal compares the actual value with the expected value after converting
+// the expected value into a slog.Value such that `int` matches `int64`
+func equal(actual, expected any) bool {
+	return reflect.DeepEqual(actual, slog.AnyValue(expected).Resolve().Any())
+}
+
+func keys(attrs map[string]any) string {
+	result := make([]string, 0, len(attrs))
+	for k := range attrs {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return "[" + strings.Join(result, ", ") + "]"
+}
@@ -0,0 +1,121 @@
+package errorstest_test
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/kapetan-io/errors/errorstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeT struct {
+	errs []string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.errs = append(f.errs, fmt.Sprintf(format, args...))
+}
+
+func fetchUser(id string) error {
+	return errors.With(errors.OtelUserID, id, "count", 1, "roles", []string{"admin"}).
+		WithAttr(slog.Group("request", "method", "GET")).
+		WithKind(errors.NotFound).
+		Errorf("while fetching user: %w", io.EOF)
+}
+
+func TestHasAttr(t *testing.T) {
+	err := fetchUser("user-1")
+	assert.True(t, errorstest.HasAttr(t, err, errors.OtelUserID, "user-1"))
+	assert.True(t, errorstest.HasAttr(t, err, "count", 1))
+	assert.True(t, errorstest.HasAttr(t, err, "roles", []string{"admin"}))
+	assert.True(t, errorstest.HasAttr(t, err, "request.method", "GET"))
+
+	var f fakeT
+	assert.False(t, errorstest.HasAttr(&f, err, "count", 2))
+	assert.False(t, errorstest.HasAttr(&f, err, "missing", 2))
+	assert.Equal(t, []string{
+		"error 'while fetching user: EOF' attribute 'count' is '1'; expected '2'",
+		"error 'while fetching user: EOF' has no attribute 'missing'; has [count, error.type, request.method, roles, user.id]",
+	}, f.errs)
+}
+
+func TestAttrsEqual(t *testing.T) {
+	err := fetchUser("user-1")
+	assert.True(t, errorstest.AttrsEqual(t, err, map[string]any{
+		errors.OtelUserID:    "user-1",
+		errors.OtelErrorType: "not_found",
+		"count":              1,
+		"roles":              []string{"admin"},
+		"request.method":     "GET",
+	}))
+
+	var f fakeT
+	assert.False(t, errorstest.AttrsEqual(&f, err, map[string]any{
+		errors.OtelUserID: "user-2",
+		"missing":         true,
+	}))
+	require.Len(t, f.errs, 1)
+	assert.Equal(t, "error 'while fetching user: EOF' attributes do not match; "+
+		"'user.id' is 'user-1'; expected 'user-2', missing 'missing', unexpected 'count', "+
+		"unexpected 'error.type', unexpected 'request.method', unexpected 'roles'", f.errs[0])
+}
+
+func TestCreatedAt(t *testing.T) {
+	err := fetchUser("user-1")
+	assert.True(t, errorstest.CreatedAt(t, err, "fetchUser", "errorstest_test.go"))
+	assert.True(t, errorstest.CreatedAt(t, err, "github.com/kapetan-io/errors/errorstest_test.fetchUser", "errorstest/errorstest_test.go"))
+
+	var f fakeT
+	assert.False(t, errorstest.CreatedAt(&f, err, "User", "errorstest_test.go"))
+	assert.False(t, errorstest.CreatedAt(&f, err, "fetchUser", "test.go"))
+	assert.False(t, errorstest.CreatedAt(&f, io.EOF, "fetchUser", "errorstest_test.go"))
+	require.Len(t, f.errs, 3)
+	assert.Equal(t, "error 'while fetching user: EOF' was created in function "+
+		"'github.com/kapetan-io/errors/errorstest_test.fetchUser'; expected 'User'", f.errs[0])
+	assert.True(t, strings.HasPrefix(f.errs[1], "error 'while fetching user: EOF' was created in file '"), f.errs[1])
+	assert.Equal(t, "error 'EOF' has no code location", f.errs[2])
+}
+
+func TestKindIs(t *testing.T) {
+	err := fetchUser("user-1")
+	assert.True(t, errorstest.KindIs(t, err, errors.NotFound))
+
+	var f fakeT
+	assert.False(t, errorstest.KindIs(&f, err, errors.Internal))
+	assert.Equal(t, []string{"error 'while fetching user: EOF' is of kind 'not_found'; expected 'internal'"}, f.errs)
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Setenv(errorstest.UpdateEnv, "")
+	err := errors.With("user.id", "user-1").WithStack().Errorf("while fetching user: %w", io.EOF)
+	out := errorstest.Normalize(err)
+	assert.Equal(t, "while fetching user: EOF (user.id=user-1)\n"+
+		"github.com/kapetan-io/errors/errorstest_test.TestSnapshot\n\terrorstest_test.go", out)
+	assert.NotContains(t, out, "testing.tRunner")
+
+	assert.True(t, errorstest.Snapshot(t, err, "testdata/snapshot.golden"))
+
+	var f fakeT
+	other := errors.With("user.id", "user-2").WithStack().Errorf("while fetching user: %w", io.EOF)
+	assert.False(t, errorstest.Snapshot(&f, other, "testdata/snapshot.golden"))
+	assert.False(t, errorstest.Snapshot(&f, other, "testdata/missing.golden"))
+	require.Len(t, f.errs, 2)
+	assert.True(t, strings.HasPrefix(f.errs[0], "error does not match golden file 'testdata/snapshot.golden'"), f.errs[0])
+	assert.True(t, strings.HasPrefix(f.errs[1], "while reading golden file"), f.errs[1])
+
+	t.Setenv(errorstest.UpdateEnv, "1")
+	golden := filepath.Join(t.TempDir(), "new", "snapshot.golden")
+	assert.True(t, errorstest.Snapshot(t, other, golden))
+	b, rerr := os.ReadFile(golden)
+	require.NoError(t, rerr)
+	assert.Equal(t, errorstest.Normalize(other), string(b))
+}
@@ -0,0 +1,96 @@
+package errors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// FingerprintKey is the attribute key used when the fingerprint is included by AttrsFromAll()
+const FingerprintKey = "error.fingerprint"
+
+var includeFingerprint atomic.Bool
+
+// SetIncludeFingerprint enables or disables including the FingerprintKey attribute
+// in the attributes returned by AttrsFromAll(). The default is disabled.
+func SetIncludeFingerprint(enabled bool) {
+	includeFingerprint.Store(enabled)
+}
+
+var (
+	fpUUID   = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	fpQuoted = regexp.MustCompile(`'[^']*'|"[^"]*"`)
+	fpHex    = regexp.MustCompile(`\b0x[0-9a-fA-F]+`)
+	fpNumber = regexp.MustCompile(`\b\d+(\.\d+)?`)
+)
+
+// Fingerprint returns a stable identifier for the err tree which can be used to group
+// occurrences of the same error. The fingerprint is computed from the code location
+// (function, file name and line) of each layer in the err tree, the Kind of each layer
+// and the message with volatile values such as numbers, UUIDs and quoted strings removed.
+// Attribute values are ignored, as such errors created at the same code locations with
+// different attribute values have the same fingerprint.
+//
+//	err := errors.With("id", id).Errorf("user %d not found", id)
+//
+//	// Prints the same fingerprint for every id
+//	fmt.Println(errors.Fingerprint(err))
+//
+// The fingerprint is stable across process restarts and builds where the code which
+// created the err tree did not move. Returns an empty string if err is nil.
+func Fingerprint(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(normalizeMessage(err.Error()))
+	if layers, ok := layersFromTree(err); ok {
+		fingerprintLayers(&b, layers)
+	} else {
+		fmt.Fprintf(&b, "\n%T", err)
+	}
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:16])
+}
+
+func fingerprintLayers(b *strings.Builder, layers []layer) {
+	for _, l := range layers {
+		if l.branches != nil {
+			for _, branch := range l.branches {
+				b.WriteString("\n[")
+				fingerprintLayers(b, branch)
+				b.WriteString("\n]")
+			}
+			continue
+		}
+		fmt.Fprintf(b, "\n%s %s:%d", l.frame.Function, filepath.Base(l.frame.File), l.frame.Line)
+		for _, a := range l.attrs {
+			if a.Key == OtelErrorType {
+				fmt.Fprintf(b, " %s", a.Value.String())
+			}
+		}
+	}
+}
+
+// normalizeMessage removes volatile values from the message, such that
+// `user 1234 not found` becomes `user <n> not found`
+func normalizeMessage(msg string) string {
+	msg = fpUUID.ReplaceAllString(msg, "<uuid>")
+	msg = fpQuoted.ReplaceAllString(msg, "<str>")
+	msg = fpHex.ReplaceAllString(msg, "<hex>")
+	return fpNumber.ReplaceAllString(msg, "<n>")
+}
+
+func fingerprintAttr(err error) []slog.Attr {
+	if !includeFingerprint.Load() {
+		return nil
+	}
+	return []slog.Attr{slog.String(FingerprintKey, Fingerprint(err))}
+}
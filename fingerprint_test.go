@@ -0,0 +1,72 @@
+package errors_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findUser(id int, name string) error {
+	err := errors.With("id", id).WithKind(errors.NotFound).Errorf("user %d '%s' not found", id, name)
+	return errors.With("name", name).Errorf("while fetching user %d: %w", id, err)
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Run("Stable", func(t *testing.T) {
+		fp := errors.Fingerprint(findUser(1, "thrawn"))
+		assert.Len(t, fp, 32)
+		assert.Equal(t, fp, errors.Fingerprint(findUser(1234, "pellaeon")))
+	})
+
+	t.Run("CodeLocation", func(t *testing.T) {
+		err1 := errors.Error("error")
+		err2 := errors.Error("error")
+		assert.NotEqual(t, errors.Fingerprint(err1), errors.Fingerprint(err2))
+	})
+
+	t.Run("Kind", func(t *testing.T) {
+		var errs []error
+		for _, k := range []errors.Kind{errors.NotFound, errors.Internal} {
+			errs = append(errs, errors.WithKind(k).Error("error"))
+		}
+		assert.NotEqual(t, errors.Fingerprint(errs[0]), errors.Fingerprint(errs[1]))
+	})
+
+	t.Run("Message", func(t *testing.T) {
+		var errs []error
+		for _, msg := range []string{"request 0x1f failed after 1.5s", "request 0xff failed after 3s", "request failed"} {
+			errs = append(errs, errors.Error(msg))
+		}
+		assert.Equal(t, errors.Fingerprint(errs[0]), errors.Fingerprint(errs[1]))
+		assert.NotEqual(t, errors.Fingerprint(errs[0]), errors.Fingerprint(errs[2]))
+	})
+
+	t.Run("StdError", func(t *testing.T) {
+		assert.NotEmpty(t, errors.Fingerprint(io.EOF))
+		assert.NotEqual(t, errors.Fingerprint(io.EOF), errors.Fingerprint(io.ErrUnexpectedEOF))
+		assert.Empty(t, errors.Fingerprint(nil))
+	})
+
+	t.Run("Join", func(t *testing.T) {
+		var errs []error
+		for i := 0; i < 2; i++ {
+			errs = append(errs, errors.Join(findUser(i, "thrawn"), errors.Error("error")))
+		}
+		assert.Equal(t, errors.Fingerprint(errs[0]), errors.Fingerprint(errs[1]))
+	})
+
+	t.Run("AttrsFromAll", func(t *testing.T) {
+		err := findUser(1, "thrawn")
+		assert.Nil(t, findAttr(errors.AttrsFromAll(err), errors.FingerprintKey))
+
+		errors.SetIncludeFingerprint(true)
+		defer errors.SetIncludeFingerprint(false)
+		a := findAttr(errors.AttrsFromAll(err), errors.FingerprintKey)
+		require.NotNil(t, a)
+		assert.Equal(t, errors.Fingerprint(err), a.Value.String())
+		assert.NotNil(t, findAttr(errors.AttrsFromAll(io.EOF), errors.FingerprintKey))
+	})
+}
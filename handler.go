@@ -0,0 +1,155 @@
+package errors
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// HandlerOptions are options for a Handler
+type HandlerOptions struct {
+	// CodeLoc when true includes the OTEL code location attributes of the error.
+	// See AttrsFromWithCodeLoc()
+	CodeLoc bool
+}
+
+// Handler is a slog.Handler which expands any attribute whose value is an error
+// with attributes in its err tree into the error message and the attributes
+// attached to the error. This allows the use of plain slog calls without losing
+// the context attached to the error.
+//
+//	log := slog.New(errors.NewHandler(slog.NewTextHandler(os.Stdout, nil), nil))
+//	err := errors.With("foo", "bar").Error("query failed")
+//
+//	// Prints `level=ERROR msg=failed err="query failed" foo=bar`
+//	log.Error("failed", "err", err)
+//
+// Handler also records the attributes and groups added via `WithAttrs()` and `WithGroup()`
+// such that errors.Logger() can attach them to errors.
+type Handler struct {
+	next slog.Handler
+	opts HandlerOptions
+	goas []groupOrAttrs
+}
+
+// groupOrAttrs holds either a group name or a list of attributes
+// in the order they were added to the Handler.
+type groupOrAttrs struct {
+	group string
+	attrs []slog.Attr
+}
+
+// Logger returns an *Attrs which includes the attributes and groups attached to
+// the logger via `log.With()` and `log.WithGroup()`. The logger must use a Handler
+// returned by NewHandler(), otherwise an empty *Attrs is returned.
+//
+//	log := slog.New(errors.NewHandler(slog.NewTextHandler(os.Stdout, nil), nil))
+//	log = log.With("component", "db")
+//
+//	// Prints `query failed (component=db, foo=bar)`
+//	fmt.Printf("%+v\n", errors.Logger(log).With("foo", "bar").Error("query failed"))
+func Logger(l *slog.Logger) *Attrs {
+	if l == nil {
+		return &Attrs{}
+	}
+	h, ok := l.Handler().(*Handler)
+	if !ok {
+		return &Attrs{}
+	}
+	return &Attrs{attrs: h.attrs()}
+}
+
+// NewHandler returns a Handler which expands error attributes before passing the
+// record to the next handler. If opts is nil, the default options are used.
+func NewHandler(next slog.Handler, opts *HandlerOptions) *Handler {
+	h := &Handler{next: next}
+	if opts != nil {
+		h.opts = *opts
+	}
+	return h
+}
+
+// Enabled reports whether the next handler handles records at the given level.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle expands any error attributes in the record and passes the record
+// to the next handler.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	n := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		n.AddAttrs(h.expand(a)...)
+		return true
+	})
+	return h.next.Handle(ctx, n)
+}
+
+// WithAttrs returns a new Handler whose attributes consist of both the
+// receiver's attributes and the expanded arguments.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var expanded []slog.Attr
+	for _, a := range attrs {
+		expanded = append(expanded, h.expand(a)...)
+	}
+	return &Handler{
+		next: h.next.WithAttrs(expanded),
+		opts: h.opts,
+		goas: append(slices.Clip(h.goas), groupOrAttrs{attrs: expanded}),
+	}
+}
+
+// WithGroup returns a new Handler with the given group appended to
+// the receiver's existing groups.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{
+		next: h.next.WithGroup(name),
+		opts: h.opts,
+		goas: append(slices.Clip(h.goas), groupOrAttrs{group: name}),
+	}
+}
+
+// attrs returns the attributes added via WithAttrs() nested within
+// any groups added via WithGroup(). Empty groups are omitted.
+func (h *Handler) attrs() []slog.Attr {
+	var result []slog.Attr
+	for i := len(h.goas) - 1; i >= 0; i-- {
+		goa := h.goas[i]
+		if goa.group == "" {
+			result = append(slices.Clone(goa.attrs), result...)
+			continue
+		}
+		if len(result) != 0 {
+			result = []slog.Attr{{Key: goa.group, Value: slog.GroupValue(result...)}}
+		}
+	}
+	return result
+}
+
+// expand returns the error message and the attributes from the err tree if
+// the attribute value is an error which has attributes. Otherwise it returns
+// the attribute unchanged.
+func (h *Handler) expand(a slog.Attr) []slog.Attr {
+	switch a.Value.Kind() {
+	case slog.KindGroup:
+		var attrs []slog.Attr
+		for _, ga := range a.Value.Group() {
+			attrs = append(attrs, h.expand(ga)...)
+		}
+		return []slog.Attr{{Key: a.Key, Value: slog.GroupValue(attrs...)}}
+	case slog.KindAny, slog.KindLogValuer:
+		err, ok := a.Value.Any().(error)
+		if !ok {
+			break
+		}
+		if _, _, ok := attrsFromTree(err); !ok {
+			break
+		}
+		result := []slog.Attr{slog.String(a.Key, err.Error())}
+		if h.opts.CodeLoc {
+			return append(result, AttrsFromWithCodeLoc(err)...)
+		}
+		return append(result, AttrsFrom(err)...)
+	}
+	return []slog.Attr{a}
+}
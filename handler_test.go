@@ -0,0 +1,100 @@
+package errors_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLogger(w *bytes.Buffer, opts *errors.HandlerOptions) *slog.Logger {
+	return slog.New(errors.NewHandler(slog.NewTextHandler(w, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		}}), opts))
+}
+
+func TestHandler(t *testing.T) {
+	var w bytes.Buffer
+	log := newTestLogger(&w, nil)
+	err := errors.With("foo", "bar").Error("query failed")
+
+	t.Run("ExpandsErrorAttrs", func(t *testing.T) {
+		w.Reset()
+		log.Error("failed", "err", err)
+		assert.Equal(t, "level=ERROR msg=failed err=\"query failed\" foo=bar\n", w.String())
+	})
+
+	t.Run("ExpandsWrappedErrors", func(t *testing.T) {
+		w.Reset()
+		log.Error("failed", "err", fmt.Errorf("wrapped: %w", err))
+		assert.Equal(t, "level=ERROR msg=failed err=\"wrapped: query failed\" foo=bar\n", w.String())
+	})
+
+	t.Run("ExpandsWithinGroups", func(t *testing.T) {
+		w.Reset()
+		log.Error("failed", slog.Group("request", "id", 1, "err", err))
+		assert.Equal(t, "level=ERROR msg=failed request.id=1 request.err=\"query failed\" request.foo=bar\n", w.String())
+	})
+
+	t.Run("ExpandsLoggerAttrs", func(t *testing.T) {
+		w.Reset()
+		log.With("err", err).WithGroup("g").Info("failed", "k", "v")
+		assert.Equal(t, "level=INFO msg=failed err=\"query failed\" foo=bar g.k=v\n", w.String())
+	})
+
+	t.Run("IgnoresErrorsWithoutAttrs", func(t *testing.T) {
+		w.Reset()
+		log.Error("failed", "err", errors.New("plain"))
+		assert.Equal(t, "level=ERROR msg=failed err=plain\n", w.String())
+	})
+
+	t.Run("CodeLoc", func(t *testing.T) {
+		w.Reset()
+		newTestLogger(&w, &errors.HandlerOptions{CodeLoc: true}).Error("failed", "err", err)
+		assert.Contains(t, w.String(), "err=\"query failed\" foo=bar code.filepath=")
+		assert.Contains(t, w.String(), "code.function=github.com/kapetan-io/errors_test.TestHandler")
+	})
+}
+
+func TestLogger(t *testing.T) {
+	var w bytes.Buffer
+	log := newTestLogger(&w, nil)
+
+	t.Run("Attrs", func(t *testing.T) {
+		l := log.With("component", "db")
+		err := errors.Logger(l).With("foo", "bar").Error("query failed")
+		assert.Equal(t, "query failed (component=db, foo=bar)", fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("Groups", func(t *testing.T) {
+		l := log.With("component", "db").WithGroup("query").With("table", "users", "id", 1).WithGroup("empty")
+		err := errors.Logger(l).Error("query failed")
+		assert.Equal(t, "[component=db query=[table=users id=1]]", fmt.Sprintf("%v", errors.AttrsFrom(err)))
+
+		// The logger is unaffected
+		w.Reset()
+		l.Info("done", "k", "v")
+		assert.Equal(t, "level=INFO msg=done component=db query.table=users query.id=1 query.empty.k=v\n", w.String())
+	})
+
+	t.Run("DoesNotModifyParent", func(t *testing.T) {
+		l := log.With("component", "db")
+		_ = l.With("one", 1)
+		two := l.With("two", 2)
+		assert.Equal(t, "[component=db two=2]", fmt.Sprintf("%v", errors.AttrsFrom(errors.Logger(two).Error("error"))))
+		assert.Equal(t, "[component=db]", fmt.Sprintf("%v", errors.AttrsFrom(errors.Logger(l).Error("error"))))
+	})
+
+	t.Run("UnknownHandler", func(t *testing.T) {
+		l := slog.New(slog.NewTextHandler(&w, nil)).With("component", "db")
+		assert.Equal(t, "error ()", fmt.Sprintf("%+v", errors.Logger(l).Error("error")))
+		assert.Equal(t, "error ()", fmt.Sprintf("%+v", errors.Logger(nil).Error("error")))
+	})
+}
@@ -0,0 +1,347 @@
+// Package httperr renders errors as RFC 9457 `application/problem+json` responses and
+// parses problem+json responses back into errors with attributes.
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//		if err := doThing(r); err != nil {
+//			httperr.Write(w, err, &httperr.Options{Extensions: []string{errors.OtelUserID}})
+//			return
+//		}
+//	}
+//
+//	resp, err := http.Get(url)
+//	if resp.StatusCode != http.StatusOK {
+//		// Returns an error with the same attributes as the error which was written
+//		return httperr.Parse(resp)
+//	}
+package httperr
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"math"
+	"mime"
+	"net/http"
+	"sort"
+
+	"github.com/kapetan-io/errors"
+)
+
+// ContentType is the media type of an RFC 9457 problem details object
+const ContentType = "application/problem+json"
+
+// Problem is an RFC 9457 problem details object. Extension members are
+// marshalled as top level members of the JSON object.
+type Problem struct {
+	Type       string
+	Title      string
+	Status     int
+	Detail     string
+	Instance   string
+	Extensions map[string]any
+}
+
+// Options are the options used when rendering an error as a Problem
+type Options struct {
+	// Extensions is the list of attribute keys which are included as extension
+	// members of the problem. Attributes not in this list are never included.
+	Extensions []string
+
+	// TypeURI returns the problem type URI for the Kind of the error. If nil
+	// or an empty string is returned, the type is "about:blank"
+	TypeURI func(errors.Kind) string
+
+	// Detail returns the detail member of the problem for the error. If nil, the
+	// error message with the Redactor set by errors.SetRedactor() applied is used.
+	// Return an empty string to omit the detail from the problem.
+	Detail func(error) string
+}
+
+// kindStatus maps an errors.Kind to an HTTP status code
+var kindStatus = map[errors.Kind]int{
+	errors.InvalidArgument:    http.StatusBadRequest,
+	errors.FailedPrecondition: http.StatusBadRequest,
+	errors.NotFound:           http.StatusNotFound,
+	errors.AlreadyExists:      http.StatusConflict,
+	errors.PermissionDenied:   http.StatusForbidden,
+	errors.Unauthenticated:    http.StatusUnauthorized,
+	errors.ResourceExhausted:  http.StatusTooManyRequests,
+	errors.Unimplemented:      http.StatusNotImplemented,
+	errors.Unavailable:        http.StatusServiceUnavailable,
+	errors.Internal:           http.StatusInternalServerError,
+}
+
+// New returns the Problem for the given error. The status is taken from the
+// OTEL 'http.response.status_code' attribute if present in the err tree and is
+// a 4xx or 5xx status, else it is derived from the errors.Kind of the error,
+// defaulting to 500. The instance is taken from the OTEL 'url.path' attribute
+// if present. If err is nil, a problem with a status of 500 and no detail is returned.
+func New(err error, opts *Options) Problem {
+	if opts == nil {
+		opts = &Options{}
+	}
+	p := Problem{
+		Type:   "about:blank",
+		Status: http.StatusInternalServerError,
+	}
+	if err == nil {
+		p.Title = http.StatusText(p.Status)
+		return p
+	}
+
+	if opts.Detail != nil {
+		p.Detail = opts.Detail(err)
+	} else {
+		p.Detail = errors.RedactMessage(err.Error())
+	}
+
+	kind := errors.KindOf(err)
+	if s, ok := kindStatus[kind]; ok {
+		p.Status = s
+	}
+	if opts.TypeURI != nil && kind != "" {
+		if t := opts.TypeURI(kind); t != "" {
+			p.Type = t
+		}
+	}
+
+	allowed := make(map[string]bool, len(opts.Extensions))
+	for _, k := range opts.Extensions {
+		allowed[k] = true
+	}
+
+	for _, a := range errors.AttrsFrom(err) {
+		switch a.Key {
+		case errors.OtelHTTPResponseStatusCode:
+			if s, ok := statusFromValue(a.Value.Resolve()); ok {
+				p.Status = s
+			}
+			continue
+		case errors.OtelURLPath:
+			p.Instance = a.Value.Resolve().String()
+			continue
+		}
+		if !allowed[a.Key] || isMember(a.Key) {
+			continue
+		}
+		if p.Extensions == nil {
+			p.Extensions = make(map[string]any)
+		}
+		p.Extensions[a.Key] = valueToAny(a.Value)
+	}
+	if kind != "" && allowed[errors.OtelErrorType] {
+		if p.Extensions == nil {
+			p.Extensions = make(map[string]any)
+		}
+		p.Extensions[errors.OtelErrorType] = string(kind)
+	}
+	p.Title = http.StatusText(p.Status)
+	return p
+}
+
+// Write writes the error to the response as an `application/problem+json` object
+// with the status code of the Problem. See New()
+func Write(w http.ResponseWriter, err error, opts *Options) {
+	p := New(err, opts)
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// Parse reads the `application/problem+json` response body and returns an error
+// which includes the extension members of the problem as attributes. The status
+// of the problem is included as the OTEL 'http.response.status_code' attribute
+// and the instance as the OTEL 'url.path' attribute. If the problem includes
+// an 'error.type' extension member, it is attached to the error as an errors.Kind.
+//
+// If the response is not a problem, the returned error includes the status code
+// and the response body as the error message.
+func Parse(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.With(errors.OtelHTTPResponseStatusCode, resp.StatusCode).
+			Errorf("while reading problem response body: %w", err)
+	}
+
+	mt, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mt != ContentType {
+		msg := string(bytes.TrimSpace(body))
+		if msg == "" {
+			msg = http.StatusText(resp.StatusCode)
+		}
+		return errors.With(errors.OtelHTTPResponseStatusCode, resp.StatusCode).Error(msg)
+	}
+
+	var p Problem
+	if err := json.Unmarshal(body, &p); err != nil {
+		return errors.With(errors.OtelHTTPResponseStatusCode, resp.StatusCode).
+			Errorf("while decoding problem response body: %w", err)
+	}
+	if p.Status == 0 {
+		p.Status = resp.StatusCode
+	}
+	return p.toError()
+}
+
+// MarshalJSON implements json.Marshaler
+func (p Problem) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(p.Extensions)+5)
+	for k, v := range p.Extensions {
+		m[k] = v
+	}
+	if p.Type != "" {
+		m["type"] = p.Type
+	}
+	if p.Title != "" {
+		m["title"] = p.Title
+	}
+	if p.Status != 0 {
+		m["status"] = p.Status
+	}
+	if p.Detail != "" {
+		m["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		m["instance"] = p.Instance
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Members which are not problem
+// members are placed in Extensions.
+func (p *Problem) UnmarshalJSON(b []byte) error {
+	var m map[string]any
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&m); err != nil {
+		return err
+	}
+
+	*p = Problem{}
+	for k, v := range m {
+		switch k {
+		case "type":
+			p.Type, _ = v.(string)
+		case "title":
+			p.Title, _ = v.(string)
+		case "detail":
+			p.Detail, _ = v.(string)
+		case "instance":
+			p.Instance, _ = v.(string)
+		case "status":
+			if n, ok := v.(json.Number); ok {
+				s, _ := n.Int64()
+				p.Status = int(s)
+			}
+		default:
+			if p.Extensions == nil {
+				p.Extensions = make(map[string]any)
+			}
+			p.Extensions[k] = v
+		}
+	}
+	return nil
+}
+
+func (p Problem) toError() error {
+	msg := p.Detail
+	if msg == "" {
+		msg = p.Title
+	}
+	if msg == "" {
+		msg = http.StatusText(p.Status)
+	}
+
+	attrs := errors.With(errors.OtelHTTPResponseStatusCode, p.Status)
+	if p.Instance != "" {
+		attrs = attrs.With(errors.OtelURLPath, p.Instance)
+	}
+
+	keys := make([]string, 0, len(p.Extensions))
+	for k := range p.Extensions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if k == errors.OtelErrorType {
+			if s, ok := p.Extensions[k].(string); ok {
+				attrs = attrs.WithKind(errors.Kind(s))
+				continue
+			}
+		}
+		attrs = attrs.WithAttr(anyToAttr(k, p.Extensions[k]))
+	}
+	return attrs.Error(msg)
+}
+
+func isMember(key string) bool {
+	switch key {
+	case "type", "title", "status", "detail", "instance":
+		return true
+	}
+	return false
+}
+
+// statusFromValue returns the status code in v if it is a 4xx or 5xx status
+func statusFromValue(v slog.Value) (int, bool) {
+	var status int64
+	switch v.Kind() {
+	case slog.KindInt64:
+		status = v.Int64()
+	case slog.KindUint64:
+		if v.Uint64() > math.MaxInt64 {
+			return 0, false
+		}
+		status = int64(v.Uint64())
+	case slog.KindAny:
+		i, ok := v.Any().(int)
+		if !ok {
+			return 0, false
+		}
+		status = int64(i)
+	default:
+		return 0, false
+	}
+	if status < 400 || status > 599 {
+		return 0, false
+	}
+	return int(status), true
+}
+
+// valueToAny converts a slog.Value into a value which can be marshalled as JSON
+func valueToAny(v slog.Value) any {
+	v = v.Resolve()
+	if v.Kind() != slog.KindGroup {
+		return v.Any()
+	}
+	m := make(map[string]any)
+	for _, a := range v.Group() {
+		m[a.Key] = valueToAny(a.Value)
+	}
+	return m
+}
+
+// anyToAttr converts a value decoded from JSON into a slog.Attr
+func anyToAttr(key string, v any) slog.Attr {
+	switch x := v.(type) {
+	case json.Number:
+		if i, err := x.Int64(); err == nil {
+			return slog.Int64(key, i)
+		}
+		f, _ := x.Float64()
+		return slog.Float64(key, f)
+	case map[string]any:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		attrs := make([]slog.Attr, 0, len(x))
+		for _, k := range keys {
+			attrs = append(attrs, anyToAttr(k, x[k]))
+		}
+		return slog.Attr{Key: key, Value: slog.GroupValue(attrs...)}
+	}
+	return slog.Any(key, v)
+}
@@ -0,0 +1,172 @@
+package httperr_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/kapetan-io/errors/httperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	opts := &httperr.Options{
+		Extensions: []string{errors.OtelUserID, "count", "request", errors.OtelErrorType},
+	}
+
+	t.Run("Kind", func(t *testing.T) {
+		err := errors.WithKind(errors.NotFound).
+			With(errors.OtelUserID, "user-1", "count", 2, "secret", "hidden").
+			WithAttr(slog.Group("request", "id", "req-1"), slog.String(errors.OtelURLPath, "/users/user-1")).
+			Error("user not found")
+
+		w := httptest.NewRecorder()
+		httperr.Write(w, err, opts)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, httperr.ContentType, w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"type": "about:blank",
+			"title": "Not Found",
+			"status": 404,
+			"detail": "user not found",
+			"instance": "/users/user-1",
+			"user.id": "user-1",
+			"count": 2,
+			"request": {"id": "req-1"},
+			"error.type": "not_found"
+		}`, w.Body.String())
+	})
+
+	t.Run("StatusCodeAttr", func(t *testing.T) {
+		err := errors.WithKind(errors.NotFound).With(errors.OtelHTTPResponseStatusCode, http.StatusGone).Error("gone")
+		p := httperr.New(fmt.Errorf("wrapped: %w", err), nil)
+		assert.Equal(t, http.StatusGone, p.Status)
+		assert.Equal(t, "Gone", p.Title)
+		assert.Equal(t, "wrapped: gone", p.Detail)
+		assert.Nil(t, p.Extensions)
+	})
+
+	t.Run("InvalidStatusCodeAttr", func(t *testing.T) {
+		for _, status := range []any{0, -1, 200, 302, 600, 1000, "500"} {
+			err := errors.WithKind(errors.NotFound).With(errors.OtelHTTPResponseStatusCode, status).Error("not found")
+			w := httptest.NewRecorder()
+			httperr.Write(w, err, nil)
+			assert.Equal(t, http.StatusNotFound, w.Code, "status %v", status)
+		}
+	})
+
+	t.Run("NilError", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		httperr.Write(w, nil, opts)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.JSONEq(t, `{"type": "about:blank", "title": "Internal Server Error", "status": 500}`, w.Body.String())
+	})
+
+	t.Run("Detail", func(t *testing.T) {
+		err := errors.WithKind(errors.Internal).Error("connect to db-1.internal:5432 failed")
+		p := httperr.New(err, &httperr.Options{
+			Detail: func(error) string { return "" },
+		})
+		assert.Equal(t, "", p.Detail)
+		b, jerr := json.Marshal(p)
+		require.NoError(t, jerr)
+		assert.NotContains(t, string(b), "detail")
+
+		p = httperr.New(err, &httperr.Options{
+			Detail: func(err error) string { return "internal error, see logs" },
+		})
+		assert.Equal(t, "internal error, see logs", p.Detail)
+	})
+
+	t.Run("RedactedDetail", func(t *testing.T) {
+		errors.SetRedactor(&errors.Redactor{Detectors: []errors.Detector{errors.DetectEmail}})
+		defer errors.SetRedactor(nil)
+
+		p := httperr.New(errors.Errorf("user '%s' not found", "thrawn@example.com"), nil)
+		assert.Equal(t, "user '[REDACTED]' not found", p.Detail)
+	})
+
+	t.Run("PlainError", func(t *testing.T) {
+		p := httperr.New(errors.New("boom"), opts)
+		assert.Equal(t, http.StatusInternalServerError, p.Status)
+		assert.Equal(t, "about:blank", p.Type)
+	})
+
+	t.Run("TypeURI", func(t *testing.T) {
+		p := httperr.New(errors.WithKind(errors.Unavailable).Error("down"), &httperr.Options{
+			TypeURI: func(k errors.Kind) string { return "https://example.com/problems/" + string(k) },
+		})
+		assert.Equal(t, http.StatusServiceUnavailable, p.Status)
+		assert.Equal(t, "https://example.com/problems/unavailable", p.Type)
+	})
+}
+
+func TestParse(t *testing.T) {
+	opts := &httperr.Options{
+		Extensions: []string{errors.OtelUserID, "count", "request", errors.OtelErrorType},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/plain" {
+			http.Error(w, "plain failure", http.StatusBadGateway)
+			return
+		}
+		httperr.Write(w, errors.WithKind(errors.NotFound).
+			With(errors.OtelUserID, "user-1", "count", 2).
+			WithAttr(slog.Group("request", "id", "req-1"), slog.String(errors.OtelURLPath, r.URL.Path)).
+			Error("user not found"), opts)
+	}))
+	defer srv.Close()
+
+	t.Run("Problem", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "/users/user-1")
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		err = httperr.Parse(resp)
+		require.Error(t, err)
+		assert.EqualError(t, err, "user not found")
+		assert.True(t, errors.Is(err, errors.NotFound))
+		assert.Equal(t, "user not found (http.response.status_code=404, url.path=/users/user-1, "+
+			"count=2, request=[id=req-1], user.id=user-1, error.type=not_found)", fmt.Sprintf("%+v", err))
+
+		// The parsed error renders the same problem
+		p := httperr.New(err, opts)
+		assert.Equal(t, http.StatusNotFound, p.Status)
+		assert.Equal(t, "/users/user-1", p.Instance)
+		assert.Equal(t, map[string]any{"user.id": "user-1", "count": int64(2),
+			"request": map[string]any{"id": "req-1"}, "error.type": "not_found"}, p.Extensions)
+	})
+
+	t.Run("NotAProblem", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "/plain")
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		err = httperr.Parse(resp)
+		assert.Equal(t, "plain failure (http.response.status_code=502)", fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		var p httperr.Problem
+		require.NoError(t, json.Unmarshal([]byte(`{"status":400,"title":"Bad Request","field":"name"}`), &p))
+		assert.Equal(t, 400, p.Status)
+		assert.Equal(t, "Bad Request", p.Title)
+		assert.Equal(t, map[string]any{"field": "name"}, p.Extensions)
+
+		resp := &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header:     http.Header{"Content-Type": []string{httperr.ContentType + "; charset=utf-8"}},
+			Body:       io.NopCloser(strings.NewReader(`{"title":"Bad Request","field":"name"}`)),
+		}
+		assert.Equal(t, "Bad Request (http.response.status_code=400, field=name)",
+			fmt.Sprintf("%+v", httperr.Parse(resp)))
+	})
+}
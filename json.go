@@ -0,0 +1,329 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"time"
+)
+
+// jsonError is the JSON representation of a single error in the err tree
+type jsonError struct {
+	Type string `json:"type"`
+	Msg  string `json:"msg"`
+	Kind Kind   `json:"kind,omitempty"`
+	// Retry is the retry after duration in nanoseconds, zero if retryable without
+	// a duration and nil if not retryable.
+	Retry   *int64       `json:"retry,omitempty"`
+	Attrs   []jsonAttr   `json:"attrs,omitempty"`
+	Code    *jsonFrame   `json:"code,omitempty"`
+	Stack   []jsonFrame  `json:"stack,omitempty"`
+	Wrap    bool         `json:"wrap,omitempty"`
+	Wrapped *jsonError   `json:"wrapped,omitempty"`
+	Errors  []*jsonError `json:"errors,omitempty"`
+}
+
+// jsonAttr is the JSON representation of a slog.Attr which preserves the slog.Kind
+type jsonAttr struct {
+	Key   string          `json:"key"`
+	Kind  string          `json:"kind"`
+	Value json.RawMessage `json:"value"`
+}
+
+type jsonFrame struct {
+	Function string `json:"function"`
+	File     string `json:"filepath"`
+	Line     int    `json:"lineno"`
+}
+
+const (
+	typeErrAttrs = "*errors.ErrAttrs"
+	typeKind     = "errors.Kind"
+	typeJoinErrs = "*errors.joinErrs"
+)
+
+// MarshalJSON encodes the entire err tree as JSON, including the messages,
+// attributes, kinds, code locations and stacks of each error in the tree.
+// The Redactor set by SetRedactor() is applied to the attributes.
+// The result can be decoded with UnmarshalJSON() to reconstruct the err tree
+// in another process.
+//
+//	b, err := errors.MarshalJSON(err)
+//	// Send over the wire
+//	err, decodeErr := errors.UnmarshalJSON(b)
+//
+//	// Works as it did in the original process
+//	errors.AttrsFromWithCodeLoc(err)
+//	errors.Is(err, errors.NotFound)
+func MarshalJSON(err error) ([]byte, error) {
+	if err == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(encodeError(err))
+}
+
+// UnmarshalJSON decodes the err tree encoded by MarshalJSON(). Errors which
+// are not from this package are decoded as errors which have the same message,
+// and wrap the same errors as the original. Returns an error as the second
+// return value if 'b' could not be decoded or does not describe a valid err tree.
+func UnmarshalJSON(b []byte) (error, error) {
+	var j *jsonError
+	if err := json.Unmarshal(b, &j); err != nil {
+		return nil, err
+	}
+	if j == nil {
+		return nil, nil
+	}
+	return decodeError(j)
+}
+
+// MarshalJSON implements json.Marshaler. See MarshalJSON()
+func (e *ErrAttrs) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeError(e))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. See UnmarshalJSON()
+func (e *ErrAttrs) UnmarshalJSON(b []byte) error {
+	var j jsonError
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	err, decodeErr := decodeError(&j)
+	if decodeErr != nil {
+		return decodeErr
+	}
+	if ea, ok := err.(*ErrAttrs); ok {
+		*e = *ea
+		return nil
+	}
+	*e = ErrAttrs{attrs: &Attrs{}, wrapped: err, wrap: true}
+	return nil
+}
+
+// decodedErr represents an error which is not from this package decoded by UnmarshalJSON()
+type decodedErr struct {
+	typ     string
+	msg     string
+	wrapped error
+}
+
+func (d *decodedErr) Error() string {
+	return d.msg
+}
+
+func (d *decodedErr) Unwrap() error {
+	return d.wrapped
+}
+
+// decodedJoin represents an error with `Unwrap() []error` decoded by UnmarshalJSON()
+type decodedJoin struct {
+	typ  string
+	msg  string
+	errs []error
+}
+
+func (d *decodedJoin) Error() string {
+	return d.msg
+}
+
+func (d *decodedJoin) Unwrap() []error {
+	return d.errs
+}
+
+func encodeError(err error) *jsonError {
+	j := &jsonError{Type: fmt.Sprintf("%T", err), Msg: RedactMessage(err.Error())}
+	switch x := err.(type) {
+	case *ErrAttrs:
+		j.Kind = x.attrs.kind
+		j.Wrap = x.wrap
+		if x.attrs.retryable {
+			d := int64(x.attrs.retryAfter)
+			j.Retry = &d
+		}
+		for _, a := range redact(x.attrs.attrs) {
+			j.Attrs = append(j.Attrs, encodeAttr(a))
+		}
+		if f := x.codeLoc(); f.Function != "" || f.File != "" {
+			j.Code = &jsonFrame{Function: f.Function, File: f.File, Line: f.Line}
+		}
+		frames := x.frames
+		if x.stack != nil {
+			frames = framesFromPCs(x.stack)
+		}
+		for _, f := range frames {
+			j.Stack = append(j.Stack, jsonFrame{Function: f.Function, File: f.File, Line: f.Line})
+		}
+	case Kind:
+		j.Kind = x
+	case *decodedErr:
+		j.Type = x.typ
+	case *decodedJoin:
+		j.Type = x.typ
+	}
+
+	switch x := err.(type) {
+	case interface{ Unwrap() error }:
+		if u := x.Unwrap(); u != nil {
+			j.Wrapped = encodeError(u)
+		}
+	case interface{ Unwrap() []error }:
+		for _, e := range x.Unwrap() {
+			if e != nil {
+				j.Errors = append(j.Errors, encodeError(e))
+			}
+		}
+	}
+	return j
+}
+
+func decodeError(j *jsonError) (error, error) {
+	var (
+		wrapped error
+		errs    []error
+		err     error
+	)
+	if j.Wrapped != nil {
+		if wrapped, err = decodeError(j.Wrapped); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range j.Errors {
+		e, err := decodeError(c)
+		if err != nil {
+			return nil, err
+		}
+		errs = append(errs, e)
+	}
+
+	switch j.Type {
+	case typeKind:
+		return j.Kind, nil
+	case typeJoinErrs:
+		if len(errs) == 0 {
+			return nil, fmt.Errorf("invalid '%s'; has no errors", j.Type)
+		}
+		return &joinErrs{errs: errs}, nil
+	case typeErrAttrs:
+		if j.Wrap && wrapped == nil {
+			return nil, fmt.Errorf("invalid '%s'; wrap is set without a wrapped error", j.Type)
+		}
+		e := &ErrAttrs{attrs: &Attrs{kind: j.Kind}, wrap: j.Wrap}
+		if j.Retry != nil {
+			e.attrs.retryable = true
+			e.attrs.retryAfter = time.Duration(*j.Retry)
+		}
+		for _, ja := range j.Attrs {
+			a, err := decodeAttr(ja)
+			if err != nil {
+				return nil, err
+			}
+			e.attrs.attrs = append(e.attrs.attrs, a)
+		}
+		if j.Code != nil {
+			e.frame = runtime.Frame{Function: j.Code.Function, File: j.Code.File, Line: j.Code.Line}
+		}
+		for _, f := range j.Stack {
+			e.frames = append(e.frames, runtime.Frame{Function: f.Function, File: f.File, Line: f.Line})
+		}
+		// ErrAttrs.Unwrap() skips the error it wraps unless created by Wrap()
+		// or the wrapped error does not implement `Unwrap() error`, as is the
+		// case with Join()
+		_, unwraps := wrapped.(interface{ Unwrap() error })
+		if j.Wrap || (wrapped != nil && !unwraps && wrapped.Error() == j.Msg) {
+			e.wrapped = wrapped
+		} else {
+			e.wrapped = &decodedErr{msg: j.Msg, wrapped: wrapped}
+		}
+		return e, nil
+	}
+
+	if len(errs) != 0 {
+		return &decodedJoin{typ: j.Type, msg: j.Msg, errs: errs}, nil
+	}
+	return &decodedErr{typ: j.Type, msg: j.Msg, wrapped: wrapped}, nil
+}
+
+func encodeAttr(a slog.Attr) jsonAttr {
+	v := a.Value.Resolve()
+	j := jsonAttr{Key: a.Key, Kind: v.Kind().String()}
+
+	var raw any
+	switch v.Kind() {
+	case slog.KindGroup:
+		var group []jsonAttr
+		for _, ga := range v.Group() {
+			group = append(group, encodeAttr(ga))
+		}
+		raw = group
+	case slog.KindDuration:
+		raw = int64(v.Duration())
+	case slog.KindAny:
+		raw = v.Any()
+		if err, ok := raw.(error); ok {
+			raw = err.Error()
+			j.Kind = slog.KindString.String()
+		}
+	default:
+		raw = v.Any()
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		b, _ = json.Marshal(fmt.Sprintf("%+v", raw))
+		j.Kind = slog.KindString.String()
+	}
+	j.Value = b
+	return j
+}
+
+func decodeAttr(j jsonAttr) (slog.Attr, error) {
+	var err error
+	switch j.Kind {
+	case slog.KindString.String():
+		var v string
+		err = json.Unmarshal(j.Value, &v)
+		return slog.String(j.Key, v), err
+	case slog.KindInt64.String():
+		var v int64
+		err = json.Unmarshal(j.Value, &v)
+		return slog.Int64(j.Key, v), err
+	case slog.KindUint64.String():
+		var v uint64
+		err = json.Unmarshal(j.Value, &v)
+		return slog.Uint64(j.Key, v), err
+	case slog.KindFloat64.String():
+		var v float64
+		err = json.Unmarshal(j.Value, &v)
+		return slog.Float64(j.Key, v), err
+	case slog.KindBool.String():
+		var v bool
+		err = json.Unmarshal(j.Value, &v)
+		return slog.Bool(j.Key, v), err
+	case slog.KindDuration.String():
+		var v int64
+		err = json.Unmarshal(j.Value, &v)
+		return slog.Duration(j.Key, time.Duration(v)), err
+	case slog.KindTime.String():
+		var v time.Time
+		err = json.Unmarshal(j.Value, &v)
+		return slog.Time(j.Key, v), err
+	case slog.KindGroup.String():
+		var group []jsonAttr
+		if err = json.Unmarshal(j.Value, &group); err != nil {
+			return slog.Attr{}, err
+		}
+		attrs := make([]slog.Attr, 0, len(group))
+		for _, ja := range group {
+			a, err := decodeAttr(ja)
+			if err != nil {
+				return slog.Attr{}, err
+			}
+			attrs = append(attrs, a)
+		}
+		return slog.Attr{Key: j.Key, Value: slog.GroupValue(attrs...)}, nil
+	}
+	var v any
+	err = json.Unmarshal(j.Value, &v)
+	return slog.Any(j.Key, v), err
+}
@@ -0,0 +1,135 @@
+package errors_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	now := time.Date(2024, 9, 30, 12, 0, 0, 0, time.UTC)
+	_, pathErr := os.Open("/does/not/exist")
+	root := errors.WithKind(errors.NotFound).
+		With("string", "value", "int", 1, "uint", uint64(2), "float", 1.5, "bool", true,
+			"duration", time.Second, "time", now, "any", []int{1, 2}).
+		WithAttr(slog.Group("group", "key", "value")).
+		Errorf("query: %w", pathErr)
+	err := errors.With("foo", "bar").Wrap(fmt.Errorf("lookup: %w", root))
+
+	b, err2 := errors.MarshalJSON(err)
+	require.NoError(t, err2)
+
+	decoded, err2 := errors.UnmarshalJSON(b)
+	require.NoError(t, err2)
+	require.NotNil(t, decoded)
+
+	t.Run("Message", func(t *testing.T) {
+		assert.EqualError(t, decoded, err.Error())
+		assert.Equal(t, fmt.Sprintf("%+v", err), fmt.Sprintf("%+v", decoded))
+	})
+
+	t.Run("Attrs", func(t *testing.T) {
+		assert.Equal(t, fmt.Sprintf("%v", errors.AttrsFromAll(err)), fmt.Sprintf("%v", errors.AttrsFromAll(decoded)))
+		as := errors.AttrsFrom(decoded)
+		assert.Equal(t, slog.KindInt64, findAttr(as, "int").Value.Kind())
+		assert.Equal(t, slog.KindUint64, findAttr(as, "uint").Value.Kind())
+		assert.Equal(t, slog.KindDuration, findAttr(as, "duration").Value.Kind())
+		assert.Equal(t, slog.KindTime, findAttr(as, "time").Value.Kind())
+		assert.True(t, now.Equal(findAttr(as, "time").Value.Time()))
+		assert.Equal(t, []any{float64(1), float64(2)}, findAttr(as, "any").Value.Any())
+	})
+
+	t.Run("CodeLoc", func(t *testing.T) {
+		f := findAttr(errors.AttrsFromWithCodeLoc(decoded), errors.OtelCodeFunction)
+		require.NotNil(t, f)
+		assert.Equal(t, "github.com/kapetan-io/errors_test.TestMarshalJSON", f.Value.String())
+	})
+
+	t.Run("Is", func(t *testing.T) {
+		assert.True(t, errors.Is(decoded, errors.NotFound))
+		assert.True(t, errors.Is(decoded, &errors.ErrAttrs{}))
+		assert.Equal(t, errors.NotFound, errors.KindOf(decoded))
+		// Types which are not from this package are not reconstructed
+		assert.False(t, errors.Is(decoded, fs.ErrNotExist))
+	})
+
+	t.Run("Unwrap", func(t *testing.T) {
+		for e, d := err, decoded; e != nil; e, d = errors.Unwrap(e), errors.Unwrap(d) {
+			require.NotNil(t, d)
+			assert.Equal(t, e.Error(), d.Error())
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		again, err := errors.MarshalJSON(decoded)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(b), string(again))
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		b, err := errors.MarshalJSON(nil)
+		require.NoError(t, err)
+		decoded, err := errors.UnmarshalJSON(b)
+		require.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		json string
+		err  string
+	}{
+		{
+			name: "WrapWithoutWrapped",
+			json: `{"type":"*errors.ErrAttrs","msg":"x","wrap":true}`,
+			err:  "invalid '*errors.ErrAttrs'; wrap is set without a wrapped error",
+		},
+		{
+			name: "JoinWithoutErrors",
+			json: `{"type":"*errors.joinErrs","msg":"x"}`,
+			err:  "invalid '*errors.joinErrs'; has no errors",
+		},
+		{
+			name: "Nested",
+			json: `{"type":"*errors.ErrAttrs","msg":"x","wrapped":{"type":"*errors.joinErrs","msg":"x"}}`,
+			err:  "invalid '*errors.joinErrs'; has no errors",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := errors.UnmarshalJSON([]byte(tt.json))
+			assert.EqualError(t, err, tt.err)
+			assert.Nil(t, decoded)
+
+			var e errors.ErrAttrs
+			assert.EqualError(t, json.Unmarshal([]byte(tt.json), &e), tt.err)
+		})
+	}
+}
+
+func TestMarshalJSONJoin(t *testing.T) {
+	errors.SetCaptureStack(true)
+	one := errors.With("foo", "bar").Error("one")
+	errors.SetCaptureStack(false)
+	err := errors.With("job", "fan-out").Join(one, errors.Errorf("two: %w", errors.Unavailable))
+
+	b, err2 := json.Marshal(err)
+	require.NoError(t, err2)
+
+	var decoded errors.ErrAttrs
+	require.NoError(t, json.Unmarshal(b, &decoded))
+
+	assert.Equal(t, fmt.Sprintf("%+v", err), fmt.Sprintf("%+v", &decoded))
+	assert.Equal(t, errors.AttrsFromAll(err), errors.AttrsFromAll(&decoded))
+	assert.True(t, errors.Is(&decoded, errors.Unavailable))
+	assert.NotNil(t, findAttr(errors.AttrsFromAll(&decoded), errors.OtelExceptionStacktrace))
+}
@@ -0,0 +1,82 @@
+package errors
+
+import "log/slog"
+
+// Kind identifies a class of error, such that errors of the same Kind can be
+// handled the same way regardless of where in the err tree they are found.
+// A Kind is attached to an error via errors.WithKind() and is included in
+// the attributes of the error as the OTEL standard 'error.type' field.
+//
+//	err := errors.WithKind(errors.NotFound).With("user.id", id).Error("user not found")
+//
+//	// Matches anywhere in the err tree
+//	if errors.Is(err, errors.NotFound) {
+//
+// Since Kind implements error, it can also be wrapped directly
+//
+//	return errors.Errorf("user '%s': %w", id, errors.NotFound)
+type Kind string
+
+// Error returns the Kind as a string
+func (k Kind) Error() string {
+	return string(k)
+}
+
+// Common error kinds, modeled after gRPC status codes
+const (
+	InvalidArgument    Kind = "invalid_argument"
+	NotFound           Kind = "not_found"
+	AlreadyExists      Kind = "already_exists"
+	PermissionDenied   Kind = "permission_denied"
+	Unauthenticated    Kind = "unauthenticated"
+	ResourceExhausted  Kind = "resource_exhausted"
+	FailedPrecondition Kind = "failed_precondition"
+	Unimplemented      Kind = "unimplemented"
+	Unavailable        Kind = "unavailable"
+	Internal           Kind = "internal"
+)
+
+// WithKind returns an *Attrs which includes the given Kind
+func WithKind(k Kind) *Attrs {
+	a := &Attrs{}
+	return a.WithKind(k)
+}
+
+// WithKind returns a new *Attrs which includes the given Kind combined
+// with any existing attributes defined in the current Attrs. Only
+// one Kind can be attached, the last Kind given wins.
+func (a *Attrs) WithKind(k Kind) *Attrs {
+	n := *a
+	n.kind = k
+	return &n
+}
+
+// KindOf returns the first Kind found in the err tree, or an empty
+// Kind if the err tree has no Kind.
+func KindOf(err error) Kind {
+	for err != nil {
+		switch x := err.(type) {
+		case Kind:
+			return x
+		case interface{ Kind() Kind }:
+			return x.Kind()
+		case interface{ Unwrap() []error }:
+			for _, branch := range x.Unwrap() {
+				if k := KindOf(branch); k != "" {
+					return k
+				}
+			}
+			return ""
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// kindAttr returns the OTEL 'error.type' attribute for k
+func kindAttr(k Kind) slog.Attr {
+	return slog.String(OtelErrorType, string(k))
+}
@@ -0,0 +1,57 @@
+package errors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKind(t *testing.T) {
+	err := errors.WithKind(errors.NotFound).With("user.id", "1").Error("user not found")
+
+	t.Run("Is", func(t *testing.T) {
+		assert.True(t, errors.Is(err, errors.NotFound))
+		assert.False(t, errors.Is(err, errors.AlreadyExists))
+
+		wrap := errors.With("foo", "bar").Errorf("lookup: %w", fmt.Errorf("query: %w", err))
+		assert.True(t, errors.Is(wrap, errors.NotFound))
+		assert.True(t, errors.Is(errors.Join(errors.New("other"), wrap), errors.NotFound))
+		assert.False(t, errors.Is(errors.Errorf("no kind"), errors.NotFound))
+	})
+
+	t.Run("IsErrAttrs", func(t *testing.T) {
+		sentinel := errors.With("foo", "bar").Error("sentinel")
+		assert.True(t, errors.Is(errors.Wrap(sentinel), sentinel))
+		assert.False(t, errors.Is(err, sentinel))
+		assert.True(t, errors.Is(err, &errors.ErrAttrs{}))
+		assert.False(t, errors.Is(errors.New("plain"), &errors.ErrAttrs{}))
+	})
+
+	t.Run("WrappedKind", func(t *testing.T) {
+		wrap := errors.Errorf("user '1': %w", errors.NotFound)
+		assert.True(t, errors.Is(wrap, errors.NotFound))
+		assert.Equal(t, errors.NotFound, errors.KindOf(wrap))
+	})
+
+	t.Run("KindOf", func(t *testing.T) {
+		assert.Equal(t, errors.NotFound, errors.KindOf(fmt.Errorf("wrap: %w", err)))
+		assert.Equal(t, errors.Unavailable, errors.KindOf(errors.WithKind(errors.Unavailable).Wrap(err)))
+		assert.Equal(t, errors.NotFound, errors.KindOf(errors.Join(errors.New("one"), err)))
+		assert.Equal(t, errors.Kind(""), errors.KindOf(errors.New("plain")))
+		assert.Equal(t, errors.Kind(""), errors.KindOf(nil))
+	})
+
+	t.Run("ErrorTypeAttr", func(t *testing.T) {
+		assert.Equal(t, "user not found (user.id=1, error.type=not_found)", fmt.Sprintf("%+v", err))
+		assert.Equal(t, "[user.id=1 error.type=not_found]", fmt.Sprintf("%v", errors.AttrsFrom(err)))
+	})
+
+	t.Run("LastKindWins", func(t *testing.T) {
+		attrs := errors.WithKind(errors.NotFound)
+		err := attrs.WithKind(errors.Internal).Error("error")
+		assert.Equal(t, errors.Internal, errors.KindOf(err))
+		assert.Equal(t, errors.NotFound, errors.KindOf(attrs.Error("error")))
+	})
+}
@@ -0,0 +1,116 @@
+package errors
+
+import (
+	"log/slog"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// AttrsFromLayers returns one slog.Group for each layer of the err tree, including
+// layers without attributes, such that the path the error travelled can be seen. Each group is
+// named by the index of the layer, where 0 is the layer closest to the top of the
+// err tree, and includes the message added by the layer, the attributes of the
+// layer and the code location where the layer was created.
+//
+//	err := errors.With("foo", "bar").Error("query failed")
+//	err = errors.With("table", "users").Errorf("while fetching user: %w", err)
+//
+//	// Prints `[0=[msg=while fetching user table=users code.filepath=... code.function=... code.lineno=...]
+//	//          1=[msg=query failed foo=bar code.filepath=... code.function=... code.lineno=...]]`
+//	fmt.Printf("%v\n", errors.AttrsFromLayers(err))
+//
+// The message is omitted for layers which did not add to the message, such as those
+// created by Wrap() directly wrapping another layer. The layers of each branch of an
+// error with `Unwrap() []error` such as `errors.Join()` are grouped under the index of
+// the branch. If the err tree contains no instances of HasAttrs then
+// []slog.Attr{slog.Any("", nil)} is returned.
+func AttrsFromLayers(err error) []slog.Attr {
+	layers, ok := layersFromTree(err)
+	if !ok {
+		return []slog.Attr{slog.Any("", nil)}
+	}
+	return groupLayers(layers)
+}
+
+// Layer is a single layer of the err tree, see Layers()
+type Layer struct {
+	// Err is the error which created the layer
+	Err error
+	// Msg is the portion of the message added by the layer with the redaction policy applied
+	Msg string
+	// Attrs are the attributes of the layer with the redaction policy applied
+	Attrs []slog.Attr
+	// Frame is the code location where the layer was created
+	Frame runtime.Frame
+	// Stack is the call stack captured when the layer was created, if any
+	Stack []runtime.Frame
+	// Branches are the layers of each branch when Err is an error with `Unwrap() []error`
+	Branches [][]Layer
+}
+
+// Layers returns each layer of the err tree, including layers without attributes, where
+// the first layer is the layer closest to the top of the err tree. It provides the same
+// information as AttrsFromLayers() for use by exporters which need more than
+// attributes, such as the call stack captured by each layer. Returns nil if the
+// err tree contains no instances of HasAttrs.
+func Layers(err error) []Layer {
+	layers, ok := layersFromTree(err)
+	if !ok {
+		return nil
+	}
+	return exportLayers(layers)
+}
+
+func exportLayers(layers []layer) []Layer {
+	result := make([]Layer, 0, len(layers))
+	for i, l := range layers {
+		el := Layer{Err: l.err, Msg: RedactMessage(layerMsg(layers, i)), Frame: l.frame}
+		if l.branches != nil {
+			for _, b := range l.branches {
+				el.Branches = append(el.Branches, exportLayers(b))
+			}
+			result = append(result, el)
+			continue
+		}
+		el.Attrs = redact(l.attrs)
+		if e, ok := l.err.(*ErrAttrs); ok {
+			el.Stack = e.frames
+			if e.stack != nil {
+				el.Stack = framesFromPCs(e.stack)
+			}
+		}
+		result = append(result, el)
+	}
+	return result
+}
+
+// layerMsg returns only the portion of the message added by the layer at index i
+func layerMsg(layers []layer, i int) string {
+	msg := layers[i].msg
+	if i+1 < len(layers) && layers[i+1].msg != "" {
+		msg = strings.TrimRight(strings.TrimSuffix(msg, layers[i+1].msg), ": ")
+	}
+	return msg
+}
+
+func groupLayers(layers []layer) []slog.Attr {
+	result := make([]slog.Attr, 0, len(layers))
+	for i, l := range layers {
+		var attrs []slog.Attr
+		if msg := layerMsg(layers, i); msg != "" && l.branches == nil {
+			attrs = append(attrs, slog.String(slog.MessageKey, RedactMessage(msg)))
+		}
+
+		if l.branches != nil {
+			for n, b := range l.branches {
+				attrs = append(attrs, slog.Attr{Key: strconv.Itoa(l.indexes[n]), Value: slog.GroupValue(groupLayers(b)...)})
+			}
+		} else {
+			attrs = append(attrs, redact(l.attrs)...)
+			attrs = append(attrs, attrsFromFrame(l.frame)...)
+		}
+		result = append(result, slog.Attr{Key: strconv.Itoa(i), Value: slog.GroupValue(attrs...)})
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package errors_test
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stripCodeLoc removes the code location attributes which are sensitive to line changes
+var stripCodeLoc = regexp.MustCompile(` ?code\.filepath=\S+ code\.function=(\S+) code\.lineno=\d+`)
+
+func TestAttrsFromLayers(t *testing.T) {
+	root := errors.With("foo", "bar").Error("query failed")
+	wrap := errors.With("retry", 1).Wrap(fmt.Errorf("attempt: %w", root))
+	err := errors.With("table", "users").Errorf("while fetching user: %w", wrap)
+
+	t.Run("Layers", func(t *testing.T) {
+		out := stripCodeLoc.ReplaceAllString(fmt.Sprintf("%v", errors.AttrsFromLayers(err)), " at=$1")
+		assert.Equal(t, "[0=[msg=while fetching user table=users at=github.com/kapetan-io/errors_test.TestAttrsFromLayers] "+
+			"1=[msg=attempt retry=1 at=github.com/kapetan-io/errors_test.TestAttrsFromLayers] "+
+			"2=[msg=query failed foo=bar at=github.com/kapetan-io/errors_test.TestAttrsFromLayers]]", out)
+	})
+
+	t.Run("CodeLoc", func(t *testing.T) {
+		layers := errors.AttrsFromLayers(err)
+		assert.Len(t, layers, 3)
+		for _, l := range layers {
+			assert.Equal(t, slog.KindGroup, l.Value.Kind())
+			assert.NotNil(t, findAttr(l.Value.Group(), errors.OtelCodeLineNo))
+		}
+		first := findAttr(layers[0].Value.Group(), errors.OtelCodeLineNo).Value.Int64()
+		last := findAttr(layers[2].Value.Group(), errors.OtelCodeLineNo).Value.Int64()
+		assert.Equal(t, first, last+2)
+	})
+
+	t.Run("WithoutAttrs", func(t *testing.T) {
+		err := errors.Wrap(errors.Errorf("while fetching user: %w", errors.Error("query failed")))
+		out := stripCodeLoc.ReplaceAllString(fmt.Sprintf("%v", errors.AttrsFromLayers(err)), " at=$1")
+		assert.Equal(t, "[0=[ at=github.com/kapetan-io/errors_test.TestAttrsFromLayers.func3] "+
+			"1=[msg=while fetching user at=github.com/kapetan-io/errors_test.TestAttrsFromLayers.func3] "+
+			"2=[msg=query failed at=github.com/kapetan-io/errors_test.TestAttrsFromLayers.func3]]", out)
+	})
+
+	t.Run("Join", func(t *testing.T) {
+		joined := errors.With("job", "fan-out").Join(err, errors.Error("other"))
+		out := stripCodeLoc.ReplaceAllString(fmt.Sprintf("%v", errors.AttrsFromLayers(joined)), "")
+		assert.Equal(t, "[0=[job=fan-out] 1=[0=[0=[msg=while fetching user table=users] 1=[msg=attempt retry=1] "+
+			"2=[msg=query failed foo=bar]] 1=[0=[msg=other]]]]", out)
+	})
+
+	t.Run("Wrap", func(t *testing.T) {
+		out := stripCodeLoc.ReplaceAllString(fmt.Sprintf("%v", errors.AttrsFromLayers(errors.Wrap(root))), "")
+		assert.Equal(t, "[0=[] 1=[msg=query failed foo=bar]]", out)
+	})
+
+	t.Run("NoAttrs", func(t *testing.T) {
+		assert.Equal(t, []slog.Attr{slog.Any("", nil)}, errors.AttrsFromLayers(errors.New("plain")))
+	})
+}
+
+func TestLayers(t *testing.T) {
+	root := errors.With("foo", "bar").WithStack().Error("query failed")
+	err := errors.With("table", "users").Errorf("while fetching user: %w", root)
+
+	layers := errors.Layers(err)
+	require.Len(t, layers, 2)
+	assert.Equal(t, "while fetching user", layers[0].Msg)
+	assert.Equal(t, []slog.Attr{slog.String("table", "users")}, layers[0].Attrs)
+	assert.Equal(t, err, layers[0].Err)
+	assert.Empty(t, layers[0].Stack)
+	assert.Equal(t, "github.com/kapetan-io/errors_test.TestLayers", layers[0].Frame.Function)
+
+	assert.Equal(t, "query failed", layers[1].Msg)
+	assert.Equal(t, root, layers[1].Err)
+	require.NotEmpty(t, layers[1].Stack)
+	assert.Equal(t, "github.com/kapetan-io/errors_test.TestLayers", layers[1].Stack[0].Function)
+	assert.Equal(t, layers[0].Frame.Line-1, layers[1].Frame.Line)
+
+	joined := errors.Layers(errors.Join(err, errors.Error("error")))
+	require.Len(t, joined, 1)
+	require.Len(t, joined[0].Branches, 2)
+	assert.Len(t, joined[0].Branches[0], 2)
+	assert.Equal(t, "error", joined[0].Branches[1][0].Msg)
+
+	assert.Nil(t, errors.Layers(fmt.Errorf("error")))
+}
@@ -0,0 +1,212 @@
+package errors
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+)
+
+// MergePolicy determines how AttrsFrom*() handle attributes with the same key
+// attached by different layers of the err tree.
+type MergePolicy int
+
+const (
+	// MergeKeepAll keeps all attributes, including duplicate keys. This is the default.
+	MergeKeepAll MergePolicy = iota
+	// MergeOutermost keeps only the attribute from the layer closest to the top of the err tree
+	MergeOutermost
+	// MergeInnermost keeps only the attribute from the layer closest to the root of the err tree
+	MergeInnermost
+	// MergeSuffix keeps all attributes, appending `_1`, `_2`, etc. to the key of each
+	// subsequent attribute with the same key. Suffixes which would produce a key already
+	// in use are skipped.
+	MergeSuffix
+	// MergeGroup nests the attributes of each layer under a group named `layer_<index>`,
+	// such that they are distinct from the index groups of the branches of errors.Join()
+	MergeGroup
+)
+
+var mergePolicy atomic.Int64
+
+// SetMergePolicy sets the MergePolicy used by AttrsFrom*() when layers of the err
+// tree reuse the same attribute key.
+//
+//	errors.SetMergePolicy(errors.MergeOutermost)
+//	err := errors.With("id", 1).Wrap(errors.With("id", 2).Error("error"))
+//
+//	// Prints `[id=1]`
+//	fmt.Printf("%v\n", errors.AttrsFrom(err))
+func SetMergePolicy(p MergePolicy) {
+	mergePolicy.Store(int64(p))
+}
+
+// LayerAttr is an attribute and the layer of the err tree which produced it
+type LayerAttr struct {
+	Attr slog.Attr
+	// Layer is the index of the layer which produced the attribute, where 0 is
+	// the layer closest to the top of the err tree.
+	Layer int
+	// Frame is the code location where the layer was created
+	Frame runtime.Frame
+}
+
+// AttrsFromWithLayer returns the same attributes as AttrsFrom(), reporting which layer
+// of the err tree produced each attribute. Returns nil if the err tree contains no
+// instances of HasAttrs.
+func AttrsFromWithLayer(err error) []LayerAttr {
+	layers, ok := layersFromTree(err)
+	if !ok {
+		return nil
+	}
+	var result []LayerAttr
+	for _, la := range mergeLayers(layers, MergePolicy(mergePolicy.Load())) {
+		if as := redact([]slog.Attr{la.Attr}); len(as) != 0 {
+			la.Attr = as[0]
+			result = append(result, la)
+		}
+	}
+	return result
+}
+
+// layer is a single HasAttrs in the err tree
+type layer struct {
+	err   error
+	attrs []slog.Attr
+	frame runtime.Frame
+	// msg is the message of the error
+	msg string
+	// branches is set when the layer is an error with `Unwrap() []error`
+	branches [][]layer
+	// indexes are the index of each of the branches
+	indexes []int
+}
+
+// layersFromTree walks the err tree and returns each HasAttrs found in order from the
+// top of the tree to the root. The branches of an error with `Unwrap() []error` are
+// returned as a single layer. Returns false if the err tree contains no instances of HasAttrs.
+func layersFromTree(err error) ([]layer, bool) {
+	var layers []layer
+	for err != nil {
+		switch x := err.(type) {
+		case *ErrAttrs:
+			layers = append(layers, layer{err: x, attrs: x.attrs.all(), frame: x.codeLoc(), msg: x.Error()})
+			err = x.wrapped
+		case HasAttrs:
+			attrs, pc := x.Attrs()
+			return append(layers, layer{err: x, attrs: attrs, frame: frameFromPC(pc), msg: x.Error()}), true
+		case interface{ Unwrap() []error }:
+			l := layer{err: err, msg: err.Error()}
+			for i, branch := range x.Unwrap() {
+				if bl, ok := layersFromTree(branch); ok {
+					l.branches = append(l.branches, bl)
+					l.indexes = append(l.indexes, i)
+				}
+			}
+			if len(l.branches) == 0 {
+				return layers, len(layers) != 0
+			}
+			l.frame = l.branches[0][len(l.branches[0])-1].frame
+			return append(layers, l), true
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		default:
+			return layers, len(layers) != 0
+		}
+	}
+	return layers, len(layers) != 0
+}
+
+// mergeLayers returns the attributes of the layers with the MergePolicy applied
+func mergeLayers(layers []layer, p MergePolicy) []LayerAttr {
+	var all []LayerAttr
+	for i, l := range layers {
+		attrs := l.attrs
+		if l.branches != nil {
+			attrs = nil
+			for n, b := range l.branches {
+				var group []slog.Attr
+				for _, la := range mergeLayers(b, p) {
+					group = append(group, la.Attr)
+				}
+				if len(group) != 0 {
+					attrs = append(attrs, slog.Attr{Key: strconv.Itoa(l.indexes[n]), Value: slog.GroupValue(group...)})
+				}
+			}
+		}
+		if p == MergeGroup {
+			if len(attrs) != 0 {
+				all = append(all, LayerAttr{Attr: slog.Attr{Key: layerGroupPrefix + strconv.Itoa(i), Value: slog.GroupValue(attrs...)}, Layer: i, Frame: l.frame})
+			}
+			continue
+		}
+		for _, a := range attrs {
+			all = append(all, LayerAttr{Attr: a, Layer: i, Frame: l.frame})
+		}
+	}
+
+	switch p {
+	case MergeOutermost:
+		seen := make(map[string]bool)
+		result := all[:0:0]
+		for _, la := range all {
+			if !seen[la.Attr.Key] {
+				seen[la.Attr.Key] = true
+				result = append(result, la)
+			}
+		}
+		return result
+	case MergeInnermost:
+		last := make(map[string]int)
+		for i, la := range all {
+			last[la.Attr.Key] = i
+		}
+		result := all[:0:0]
+		for i, la := range all {
+			if last[la.Attr.Key] == i {
+				result = append(result, la)
+			}
+		}
+		return result
+	case MergeSuffix:
+		taken := make(map[string]bool, len(all))
+		for _, la := range all {
+			taken[la.Attr.Key] = true
+		}
+		seen := make(map[string]bool, len(all))
+		next := make(map[string]int)
+		for i, la := range all {
+			key := la.Attr.Key
+			if !seen[key] {
+				seen[key] = true
+				continue
+			}
+			n := next[key] + 1
+			for taken[fmt.Sprintf("%s_%d", key, n)] {
+				n++
+			}
+			next[key] = n
+			all[i].Attr.Key = fmt.Sprintf("%s_%d", key, n)
+			taken[all[i].Attr.Key] = true
+		}
+	}
+	return all
+}
+
+// layerGroupPrefix is the prefix of the group key of each layer when using MergeGroup
+const layerGroupPrefix = "layer_"
+
+// mergedAttrsFromTree returns the attributes in the err tree with the
+// MergePolicy set by SetMergePolicy() applied.
+func mergedAttrsFromTree(err error) ([]slog.Attr, bool) {
+	layers, ok := layersFromTree(err)
+	if !ok {
+		return nil, false
+	}
+	var result []slog.Attr
+	for _, la := range mergeLayers(layers, MergePolicy(mergePolicy.Load())) {
+		result = append(result, la.Attr)
+	}
+	return result, true
+}
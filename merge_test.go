@@ -0,0 +1,70 @@
+package errors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePolicy(t *testing.T) {
+	defer errors.SetMergePolicy(errors.MergeKeepAll)
+
+	inner := errors.With("id", 3, "inner", true).Error("inner")
+	middle := fmt.Errorf("middle: %w", errors.With("id", 2).Wrap(inner))
+	err := errors.With("id", 1, "outer", true).Errorf("outer: %w", middle)
+
+	for _, tt := range []struct {
+		policy   errors.MergePolicy
+		expected string
+	}{
+		{policy: errors.MergeKeepAll, expected: "[id=1 outer=true id=2 id=3 inner=true]"},
+		{policy: errors.MergeOutermost, expected: "[id=1 outer=true inner=true]"},
+		{policy: errors.MergeInnermost, expected: "[outer=true id=3 inner=true]"},
+		{policy: errors.MergeSuffix, expected: "[id=1 outer=true id_1=2 id_2=3 inner=true]"},
+		{policy: errors.MergeGroup, expected: "[layer_0=[id=1 outer=true] layer_1=[id=2] layer_2=[id=3 inner=true]]"},
+	} {
+		t.Run(fmt.Sprintf("Policy%d", tt.policy), func(t *testing.T) {
+			errors.SetMergePolicy(tt.policy)
+			assert.Equal(t, tt.expected, fmt.Sprintf("%v", errors.AttrsFrom(err)))
+		})
+	}
+
+	t.Run("Join", func(t *testing.T) {
+		errors.SetMergePolicy(errors.MergeOutermost)
+		joined := errors.With("id", 0).Join(err, errors.With("id", 4).Error("other"))
+		assert.Equal(t, "[id=0 0=[id=1 outer=true inner=true] 1=[id=4]]", fmt.Sprintf("%v", errors.AttrsFrom(joined)))
+	})
+
+	t.Run("SuffixCollision", func(t *testing.T) {
+		errors.SetMergePolicy(errors.MergeSuffix)
+		err := errors.With("id", 1, "id_1", 5).Wrap(errors.With("id", 2).Error("e"))
+		assert.Equal(t, "[id=1 id_1=5 id_2=2]", fmt.Sprintf("%v", errors.AttrsFrom(err)))
+
+		err = errors.With("id", 1).Wrap(errors.With("id", 2).Wrap(errors.With("id_1", 5, "id", 3).Error("e")))
+		assert.Equal(t, "[id=1 id_2=2 id_1=5 id_3=3]", fmt.Sprintf("%v", errors.AttrsFrom(err)))
+	})
+
+	t.Run("GroupJoin", func(t *testing.T) {
+		errors.SetMergePolicy(errors.MergeGroup)
+		joined := errors.With("id", 1).Wrap(errors.Join(errors.With("id", 2).Error("a"), errors.With("id", 3).Error("b")))
+		assert.Equal(t, "[layer_0=[id=1] layer_1=[0=[layer_0=[id=2]] 1=[layer_0=[id=3]]]]",
+			fmt.Sprintf("%v", errors.AttrsFrom(joined)))
+	})
+
+	t.Run("AttrsFromWithLayer", func(t *testing.T) {
+		errors.SetMergePolicy(errors.MergeInnermost)
+		las := errors.AttrsFromWithLayer(err)
+		require.Len(t, las, 3)
+		assert.Equal(t, "outer", las[0].Attr.Key)
+		assert.Equal(t, 0, las[0].Layer)
+		assert.Equal(t, "id", las[1].Attr.Key)
+		assert.Equal(t, 2, las[1].Layer)
+		assert.Equal(t, "github.com/kapetan-io/errors_test.TestMergePolicy", las[1].Frame.Function)
+		assert.Equal(t, "inner", las[2].Attr.Key)
+
+		assert.Nil(t, errors.AttrsFromWithLayer(errors.New("no attrs")))
+	})
+}
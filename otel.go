@@ -1,66 +1,1180 @@
+// Code generated by semconvgen from semantic conventions v1.27.0. DO NOT EDIT.
+
 // These are not tied to a particular semver and are intended to be used in packages which otherwise have no
 // dependency nor need for dependency upon otel libraries.
-// Taken from https://github.com/open-telemetry/opentelemetry-collector/blob/52e11ecb8293dc9ae75edbabb4f0c176b386d9c7/semconv/v1.27.0/generated_attribute_group.go
 
 package errors
 
 const (
-	OtelClientAddress                   = "client.address"
-	OtelClientPort                      = "client.port"
-	OtelCodeFilePath                    = "code.filepath"
-	OtelCodeFunction                    = "code.function"
-	OtelCodeLineNo                      = "code.lineno"
-	OtelCodeNamespace                   = "code.namespace"
-	OtelFileDirectory                   = "file.directory"
-	OtelFileExtension                   = "file.extension"
-	OtelFileName                        = "file.name"
-	OtelFilePath                        = "file.path"
-	OtelFileSize                        = "file.size"
-	OtelHostID                          = "host.id"
-	OtelHostIP                          = "host.ip"
-	OtelHTTPRequestBodySize             = "http.request.body.size"
-	OtelHTTPRequestMethod               = "http.request.method"
-	OtelHTTPRequestSize                 = "http.request.size"
-	OtelHTTPResponseBodySize            = "http.response.body.size"
-	OtelHTTPResponseSize                = "http.response.size"
-	OtelHTTPResponseStatusCode          = "http.response.status_code"
-	OtelHTTPUserAgentName               = "user_agent.name"
-	OtelURLDomain                       = "url.domain" // 'www.foo.bar', 'opentelemetry.io', '3.12.167.2',
-	OtelURLFull                         = "url.full"   // https://www.foo.bar/search?q=OpenTelemetry#SemConv
-	OtelURLPath                         = "url.path"   // /search /query
-	OtelURLPort                         = "url.port"   // 80 443
-	OtelURLQuery                        = "url.query"  // q=OpenTelemetry your=mom
-	OtelURLScheme                       = "url.scheme" // 'https', 'http'
-	OtelMessagingClientID               = "messaging.client.id"
-	OtelMessagingConsumerGroupName      = "messaging.consumer.group.name"
+	// Client address - domain name if available without reverse DNS lookup; otherwise, IP address or
+	// Unix domain socket name.
+	//
+	// Type: string
+	// Examples: "client.example.com", "10.1.2.80", "/tmp/my.sock"
+	// Stability: stable
+	OtelClientAddress = "client.address"
+
+	// Client port number.
+	//
+	// Type: int
+	// Examples: 65123
+	// Stability: stable
+	OtelClientPort = "client.port"
+
+	// The column number in `code.filepath` best representing the operation. It SHOULD point within the
+	// code unit named in `code.function`.
+	//
+	// Type: int
+	// Examples: 16
+	// Stability: experimental
+	OtelCodeColumn = "code.column"
+
+	// The source code file name that identifies the code unit as uniquely as possible (preferably an
+	// absolute file path).
+	//
+	// Type: string
+	// Examples: "/usr/local/MyApplication/content_root/app/index.php"
+	// Stability: experimental
+	OtelCodeFilePath = "code.filepath"
+
+	// The method or function name, or equivalent (usually rightmost part of the code unit's name).
+	//
+	// Type: string
+	// Examples: "serveRequest"
+	// Stability: experimental
+	OtelCodeFunction = "code.function"
+
+	// The line number in `code.filepath` best representing the operation. It SHOULD point within the
+	// code unit named in `code.function`.
+	//
+	// Type: int
+	// Examples: 42
+	// Stability: experimental
+	OtelCodeLineNo = "code.lineno"
+
+	// The "namespace" within which `code.function` is defined. Usually the qualified class or module
+	// name, such that `code.namespace` + some separator + `code.function` form a unique identifier for
+	// the code unit.
+	//
+	// Type: string
+	// Examples: "com.example.MyHTTPService"
+	// Stability: experimental
+	OtelCodeNamespace = "code.namespace"
+
+	// A stacktrace as a string in the natural representation for the language runtime. The
+	// representation is to be determined and documented by each language SIG.
+	//
+	// Type: string
+	// Examples: "at com.example.GenerateTrace.methodB(GenerateTrace.java:13)\\n at
+	// com.example.GenerateTrace.methodA(GenerateTrace.java:9)\\n at
+	// com.example.GenerateTrace.main(GenerateTrace.java:5)"
+	// Stability: experimental
+	OtelCodeStacktrace = "code.stacktrace"
+
+	// The name of the connection pool; unique within the instrumented application. In case the
+	// connection pool implementation doesn't provide a name, instrumentation SHOULD use a combination
+	// of parameters that would make the name unique, for example, combining attributes
+	// `server.address`, `server.port`, and `db.namespace`, formatted as
+	// `server.address:server.port/db.namespace`. Instrumentations that generate connection pool name
+	// following different patterns SHOULD document it.
+	//
+	// Type: string
+	// Examples: "myDataSource"
+	// Stability: experimental
+	OtelDBClientConnectionPoolName = "db.client.connection.pool.name"
+
+	// The state of a connection in the pool
+	//
+	// Type: string
+	// Enum: idle, used
+	// Examples: "idle"
+	// Stability: experimental
+	OtelDBClientConnectionState = "db.client.connection.state"
+
+	// The name of a collection (table, container) within the database.
+	//
+	// Type: string
+	// Examples: "public.users", "customers"
+	// Stability: experimental
+	OtelDBCollectionName = "db.collection.name"
+
+	// The name of the database, fully qualified within the server address and port.
+	//
+	// Type: string
+	// Examples: "customers", "test.users"
+	// Stability: experimental
+	OtelDBNamespace = "db.namespace"
+
+	// The number of queries included in a [batch
+	// operation](/docs/database/database-spans.md#batch-operations).
+	//
+	// Type: int
+	// Examples: 2, 3, 4
+	// Stability: experimental
+	OtelDBOperationBatchSize = "db.operation.batch.size"
+
+	// The name of the operation or command being executed.
+	//
+	// Type: string
+	// Examples: "findAndModify", "HMSET", "SELECT"
+	// Stability: experimental
+	OtelDBOperationName = "db.operation.name"
+
+	// The database query being executed.
+	//
+	// Type: string
+	// Examples: "SELECT * FROM wuser_table where username = ?", "SET mykey \"WuValue\""
+	// Stability: experimental
+	OtelDBQueryText = "db.query.text"
+
+	// The database management system (DBMS) product as identified by the client instrumentation.
+	//
+	// Type: string
+	// Enum: other_sql, adabas, cache, intersystems_cache, cassandra, clickhouse, cloudscape,
+	// cockroachdb, coldfusion, cosmosdb, couchbase, couchdb, db2, derby, dynamodb, edb, elasticsearch,
+	// filemaker, firebird, firstsql, geode, h2, hanadb, hbase, hive, hsqldb, influxdb, informix,
+	// ingres, instantdb, interbase, mariadb, maxdb, memcached, mongodb, mssql, mssqlcompact, mysql,
+	// neo4j, netezza, opensearch, oracle, pervasive, pointbase, postgresql, progress, redis, redshift,
+	// spanner, sqlite, sybase, teradata, trino, vertica
+	// Stability: experimental
+	OtelDBSystem = "db.system"
+
+	// Describes a class of error the operation ended with.
+	//
+	// Type: string
+	// Enum: _OTHER
+	// Examples: "timeout", "java.net.UnknownHostException", "server_certificate_invalid", "500"
+	// Stability: stable
+	OtelErrorType = "error.type"
+
+	// SHOULD be set to true if the exception event is recorded at a point where it is known that the
+	// exception is escaping the scope of the span.
+	//
+	// Type: boolean
+	// Stability: stable
+	OtelExceptionEscaped = "exception.escaped"
+
+	// The exception message.
+	//
+	// Type: string
+	// Examples: "Division by zero", "Can't convert 'int' object to str implicitly"
+	// Stability: stable
+	OtelExceptionMessage = "exception.message"
+
+	// A stacktrace as a string in the natural representation for the language runtime. The
+	// representation is to be determined and documented by each language SIG.
+	//
+	// Type: string
+	// Examples: "Exception in thread \"main\" java.lang.RuntimeException: Test exception\\n at
+	// com.example.GenerateTrace.methodB(GenerateTrace.java:13)\\n at
+	// com.example.GenerateTrace.methodA(GenerateTrace.java:9)\\n at
+	// com.example.GenerateTrace.main(GenerateTrace.java:5)"
+	// Stability: stable
+	OtelExceptionStacktrace = "exception.stacktrace"
+
+	// The type of the exception (its fully-qualified class name, if applicable). The dynamic type of
+	// the exception should be preferred over the static type in languages that support it.
+	//
+	// Type: string
+	// Examples: "java.net.ConnectException", "OSError"
+	// Stability: stable
+	OtelExceptionType = "exception.type"
+
+	// Directory where the file is located. It should include the drive letter, when appropriate.
+	//
+	// Type: string
+	// Examples: "/home/user", "C:\\Program Files\\MyApp"
+	// Stability: experimental
+	OtelFileDirectory = "file.directory"
+
+	// File extension, excluding the leading dot.
+	//
+	// Type: string
+	// Examples: "png", "gz"
+	// Stability: experimental
+	OtelFileExtension = "file.extension"
+
+	// Name of the file including the extension, without the directory.
+	//
+	// Type: string
+	// Examples: "example.png"
+	// Stability: experimental
+	OtelFileName = "file.name"
+
+	// Full path to the file, including the file name. It should include the drive letter, when
+	// appropriate.
+	//
+	// Type: string
+	// Examples: "/home/alice/example.png", "C:\\Program Files\\MyApp\\myapp.exe"
+	// Stability: experimental
+	OtelFilePath = "file.path"
+
+	// File size in bytes.
+	//
+	// Type: int
+	// Stability: experimental
+	OtelFileSize = "file.size"
+
+	// The CPU architecture the host system is running on.
+	//
+	// Type: string
+	// Enum: amd64, arm32, arm64, ia64, ppc32, ppc64, s390x, x86
+	// Stability: experimental
+	OtelHostArch = "host.arch"
+
+	// The amount of level 2 memory cache available to the processor (in Bytes).
+	//
+	// Type: int
+	// Examples: 12288000
+	// Stability: experimental
+	OtelHostCPUCacheL2Size = "host.cpu.cache.l2.size"
+
+	// Family or generation of the CPU.
+	//
+	// Type: string
+	// Examples: "6", "PA-RISC 1.1e"
+	// Stability: experimental
+	OtelHostCPUFamily = "host.cpu.family"
+
+	// Model identifier. It provides more granular information about the CPU, distinguishing it from
+	// other CPUs within the same family.
+	//
+	// Type: string
+	// Examples: "6", "9000/778/B180L"
+	// Stability: experimental
+	OtelHostCPUModelID = "host.cpu.model.id"
+
+	// Model designation of the processor.
+	//
+	// Type: string
+	// Examples: "11th Gen Intel(R) Core(TM) i7-1185G7 @ 3.00GHz"
+	// Stability: experimental
+	OtelHostCPUModelName = "host.cpu.model.name"
+
+	// Stepping or core revisions.
+	//
+	// Type: string
+	// Examples: "1", "r1p1"
+	// Stability: experimental
+	OtelHostCPUStepping = "host.cpu.stepping"
+
+	// Processor manufacturer identifier. A maximum 12-character string.
+	//
+	// Type: string
+	// Examples: "GenuineIntel"
+	// Stability: experimental
+	OtelHostCPUVendorID = "host.cpu.vendor.id"
+
+	// Unique host ID. For Cloud, this must be the instance_id assigned by the cloud provider. For
+	// non-containerized systems, this should be the `machine-id`. See the table below for the sources
+	// to use to determine the `machine-id` based on operating system.
+	//
+	// Type: string
+	// Examples: "fdbf79e8af94cb7f9e8df36789187052"
+	// Stability: experimental
+	OtelHostID = "host.id"
+
+	// VM image ID or host OS image ID. For Cloud, this value is from the provider.
+	//
+	// Type: string
+	// Examples: "ami-07b06b442921831e5"
+	// Stability: experimental
+	OtelHostImageID = "host.image.id"
+
+	// Name of the VM image or OS install the host was instantiated from.
+	//
+	// Type: string
+	// Examples: "infra-ami-eks-worker-node-7d4ec78312", "CentOS-8-x86_64-1905"
+	// Stability: experimental
+	OtelHostImageName = "host.image.name"
+
+	// The version string of the VM image or host OS as defined in [Version
+	// Attributes](/docs/resource/README.md#version-attributes).
+	//
+	// Type: string
+	// Examples: "0.1"
+	// Stability: experimental
+	OtelHostImageVersion = "host.image.version"
+
+	// Available IP addresses of the host, excluding loopback interfaces.
+	//
+	// Type: string[]
+	// Examples: "192.168.1.140", "fe80::abc2:4a28:737a:609e"
+	// Stability: experimental
+	OtelHostIP = "host.ip"
+
+	// Available MAC addresses of the host, excluding loopback interfaces.
+	//
+	// Type: string[]
+	// Examples: "AC-DE-48-23-45-67", "AC-DE-48-23-45-67-01-9F"
+	// Stability: experimental
+	OtelHostMac = "host.mac"
+
+	// Name of the host. On Unix systems, it may contain what the hostname command returns, or the
+	// fully qualified hostname, or another name specified by the user.
+	//
+	// Type: string
+	// Examples: "opentelemetry-test"
+	// Stability: experimental
+	OtelHostName = "host.name"
+
+	// Type of host. For Cloud, this must be the machine type.
+	//
+	// Type: string
+	// Examples: "n1-standard-1"
+	// Stability: experimental
+	OtelHostType = "host.type"
+
+	// State of the HTTP connection in the HTTP connection pool.
+	//
+	// Type: string
+	// Enum: active, idle
+	// Examples: "active", "idle"
+	// Stability: experimental
+	OtelHTTPConnectionState = "http.connection.state"
+
+	// The size of the request payload body in bytes. This is the number of bytes transferred excluding
+	// headers and is often, but not always, present as the
+	// [Content-Length](https://www.rfc-editor.org/rfc/rfc9110.html#field.content-length) header. For
+	// requests using transport encoding, this should be the compressed size.
+	//
+	// Type: int
+	// Examples: 3495
+	// Stability: experimental
+	OtelHTTPRequestBodySize = "http.request.body.size"
+
+	// HTTP request method.
+	//
+	// Type: string
+	// Enum: CONNECT, DELETE, GET, HEAD, OPTIONS, PATCH, POST, PUT, TRACE, _OTHER
+	// Examples: "GET", "POST", "HEAD"
+	// Stability: stable
+	OtelHTTPRequestMethod = "http.request.method"
+
+	// Original HTTP method sent by the client in the request line.
+	//
+	// Type: string
+	// Examples: "GeT", "ACL", "foo"
+	// Stability: stable
+	OtelHTTPRequestMethodOriginal = "http.request.method_original"
+
+	// The ordinal number of request resending attempt (for any reason, including redirects).
+	//
+	// Type: int
+	// Examples: 3
+	// Stability: stable
+	OtelHTTPRequestResendCount = "http.request.resend_count"
+
+	// The total size of the request in bytes. This should be the total number of bytes sent over the
+	// wire, including the request line (HTTP/1.1), framing (HTTP/2 and HTTP/3), headers, and request
+	// body if any.
+	//
+	// Type: int
+	// Examples: 1437
+	// Stability: experimental
+	OtelHTTPRequestSize = "http.request.size"
+
+	// The size of the response payload body in bytes. This is the number of bytes transferred
+	// excluding headers and is often, but not always, present as the
+	// [Content-Length](https://www.rfc-editor.org/rfc/rfc9110.html#field.content-length) header. For
+	// requests using transport encoding, this should be the compressed size.
+	//
+	// Type: int
+	// Examples: 3495
+	// Stability: experimental
+	OtelHTTPResponseBodySize = "http.response.body.size"
+
+	// The total size of the response in bytes. This should be the total number of bytes sent over the
+	// wire, including the status line (HTTP/1.1), framing (HTTP/2 and HTTP/3), headers, and response
+	// body and trailers if any.
+	//
+	// Type: int
+	// Examples: 1437
+	// Stability: experimental
+	OtelHTTPResponseSize = "http.response.size"
+
+	// [HTTP response status code](https://tools.ietf.org/html/rfc7231#section-6).
+	//
+	// Type: int
+	// Examples: 200
+	// Stability: stable
+	OtelHTTPResponseStatusCode = "http.response.status_code"
+
+	// The matched route, that is, the path template in the format used by the respective server
+	// framework.
+	//
+	// Type: string
+	// Examples: "/users/:userID?", "{controller}/{action}/{id?}"
+	// Stability: stable
+	OtelHTTPRoute = "http.route"
+
+	// The number of messages sent, received, or processed in the scope of the batching operation.
+	//
+	// Type: int
+	// Examples: 0, 1, 2
+	// Stability: experimental
+	OtelMessagingBatchMessageCount = "messaging.batch.message_count"
+
+	// A unique identifier for the client that consumes or produces a message.
+	//
+	// Type: string
+	// Examples: "client-5", "myhost@8742@s8083jm"
+	// Stability: experimental
+	OtelMessagingClientID = "messaging.client.id"
+
+	// The name of the consumer group with which a consumer is associated.
+	//
+	// Type: string
+	// Examples: "my-group", "indexer"
+	// Stability: experimental
+	OtelMessagingConsumerGroupName = "messaging.consumer.group.name"
+
+	// A boolean that is true if the message destination is anonymous (could be unnamed or have
+	// auto-generated name).
+	//
+	// Type: boolean
+	// Stability: experimental
+	OtelMessagingDestinationAnonymous = "messaging.destination.anonymous"
+
+	// The message destination name
+	//
+	// Type: string
+	// Examples: "MyQueue", "MyTopic"
+	// Stability: experimental
+	OtelMessagingDestinationName = "messaging.destination.name"
+
+	// The identifier of the partition messages are sent to or received from, unique within the
+	// `messaging.destination.name`.
+	//
+	// Type: string
+	// Examples: "1"
+	// Stability: experimental
 	OtelMessagingDestinationPartitionID = "messaging.destination.partition.id"
-	OtelMessagingMessageBodySize        = "messaging.message.body.size"
-	OtelMessagingMessageConversationID  = "messaging.message.conversation_id"
-	OtelMessagingMessageEnvelopeSize    = "messaging.message.envelope.size"
-	OtelMessagingMessageID              = "messaging.message.id"
-	OtelMessagingOperationName          = "messaging.operation.name" // ack, nack, send, etc..
-	OtelMessagingOperationType          = "messaging.operation.type" // publish, create, receive, settle, deliver
-	OtelMessagingSystem                 = "messaging.system"
-	OtelNetworkConnectionType           = "network.connection.type"
-	OtelNetworkLocalAddress             = "network.local.address"
-	OtelNetworkLocalPort                = "network.local.port"
-	OtelNetworkPeerAddress              = "network.peer.address"
-	OtelNetworkPeerPort                 = "network.peer.port"
-	OtelNetworkProtocolName             = "network.protocol.name" // http, amqp, mqtt, etc..
-	OtelNetworkTransport                = "network.transport"     // tcp, udp
-	OtelNetworkType                     = "network.type"          // ipv4, ipv6
-	OtelServerAddress                   = "server.address"
-	OtelServerPort                      = "server.port"
-	OtelServiceInstanceID               = "service.instance.id"
-	OtelServiceName                     = "service.name"
-	OtelServiceNamespace                = "service.namespace"
-	OtelServiceVersion                  = "service.version"
-	OtelSessionID                       = "session.id"
-	OtelTLSCipher                       = "tls.cipher"
-	OtelTLSProtocolVersion              = "tls.protocol.version"
-	OtelTLSServerSubject                = "tls.server.subject"
-	OtelUserEmail                       = "user.email"
-	OtelUserID                          = "user.id"
-	OtelUserName                        = "user.name"
-	OtelUserRoles                       = "user.roles"
+
+	// The name of the destination subscription from which a message is consumed.
+	//
+	// Type: string
+	// Examples: "subscription-a"
+	// Stability: experimental
+	OtelMessagingDestinationSubscriptionName = "messaging.destination.subscription.name"
+
+	// Low cardinality representation of the messaging destination name
+	//
+	// Type: string
+	// Examples: "/customers/{customerID}"
+	// Stability: experimental
+	OtelMessagingDestinationTemplate = "messaging.destination.template"
+
+	// A boolean that is true if the message destination is temporary and might not exist anymore after
+	// messages are processed.
+	//
+	// Type: boolean
+	// Stability: experimental
+	OtelMessagingDestinationTemporary = "messaging.destination.temporary"
+
+	// The size of the message body in bytes.
+	//
+	// Type: int
+	// Examples: 1439
+	// Stability: experimental
+	OtelMessagingMessageBodySize = "messaging.message.body.size"
+
+	// The conversation ID identifying the conversation to which the message belongs, represented as a
+	// string. Sometimes called "Correlation ID".
+	//
+	// Type: string
+	// Examples: "MyConversationID"
+	// Stability: experimental
+	OtelMessagingMessageConversationID = "messaging.message.conversation_id"
+
+	// The size of the message body and metadata in bytes.
+	//
+	// Type: int
+	// Examples: 2738
+	// Stability: experimental
+	OtelMessagingMessageEnvelopeSize = "messaging.message.envelope.size"
+
+	// A value used by the messaging system as an identifier for the message, represented as a string.
+	//
+	// Type: string
+	// Examples: "452a7c7c7c7048c2f887f61572b18fc2"
+	// Stability: experimental
+	OtelMessagingMessageID = "messaging.message.id"
+
+	// The system-specific name of the messaging operation.
+	//
+	// Type: string
+	// Examples: "ack", "nack", "send"
+	// Stability: experimental
+	OtelMessagingOperationName = "messaging.operation.name"
+
+	// A string identifying the type of the messaging operation.
+	//
+	// Type: string
+	// Enum: publish, create, receive, process, settle, deliver
+	// Stability: experimental
+	OtelMessagingOperationType = "messaging.operation.type"
+
+	// The messaging system as identified by the client instrumentation.
+	//
+	// Type: string
+	// Enum: activemq, aws_sqs, eventgrid, eventhubs, servicebus, gcp_pubsub, jms, kafka, rabbitmq,
+	// rocketmq, pulsar
+	// Stability: experimental
+	OtelMessagingSystem = "messaging.system"
+
+	// The ISO 3166-1 alpha-2 2-character country code associated with the mobile carrier network.
+	//
+	// Type: string
+	// Examples: "DE"
+	// Stability: experimental
+	OtelNetworkCarrierIcc = "network.carrier.icc"
+
+	// The mobile carrier country code.
+	//
+	// Type: string
+	// Examples: "310"
+	// Stability: experimental
+	OtelNetworkCarrierMcc = "network.carrier.mcc"
+
+	// The mobile carrier network code.
+	//
+	// Type: string
+	// Examples: "001"
+	// Stability: experimental
+	OtelNetworkCarrierMnc = "network.carrier.mnc"
+
+	// The name of the mobile carrier.
+	//
+	// Type: string
+	// Examples: "sprint"
+	// Stability: experimental
+	OtelNetworkCarrierName = "network.carrier.name"
+
+	// This describes more details regarding the connection.type. It may be the type of cell technology
+	// connection, but it could be used for describing details about a wifi connection.
+	//
+	// Type: string
+	// Enum: gprs, edge, umts, cdma, evdo_0, evdo_a, cdma2000_1xrtt, hsdpa, hsupa, hspa, iden, evdo_b,
+	// lte, ehrpd, hspap, gsm, td_scdma, iwlan, nr, nrnsa, lte_ca
+	// Examples: "LTE"
+	// Stability: experimental
+	OtelNetworkConnectionSubtype = "network.connection.subtype"
+
+	// The internet connection type.
+	//
+	// Type: string
+	// Enum: wifi, wired, cell, unavailable, unknown
+	// Examples: "wifi"
+	// Stability: experimental
+	OtelNetworkConnectionType = "network.connection.type"
+
+	// The network IO operation direction.
+	//
+	// Type: string
+	// Enum: transmit, receive
+	// Examples: "transmit"
+	// Stability: experimental
+	OtelNetworkIODirection = "network.io.direction"
+
+	// Local address of the network connection - IP address or Unix domain socket name.
+	//
+	// Type: string
+	// Examples: "10.1.2.80", "/tmp/my.sock"
+	// Stability: stable
+	OtelNetworkLocalAddress = "network.local.address"
+
+	// Local port number of the network connection.
+	//
+	// Type: int
+	// Examples: 65123
+	// Stability: stable
+	OtelNetworkLocalPort = "network.local.port"
+
+	// Peer address of the network connection - IP address or Unix domain socket name.
+	//
+	// Type: string
+	// Examples: "10.1.2.80", "/tmp/my.sock"
+	// Stability: stable
+	OtelNetworkPeerAddress = "network.peer.address"
+
+	// Peer port number of the network connection.
+	//
+	// Type: int
+	// Examples: 65123
+	// Stability: stable
+	OtelNetworkPeerPort = "network.peer.port"
+
+	// [OSI application layer](https://osi-model.com/application-layer/) or non-OSI equivalent.
+	//
+	// Type: string
+	// Examples: "amqp", "http", "mqtt"
+	// Stability: stable
+	OtelNetworkProtocolName = "network.protocol.name"
+
+	// The actual version of the protocol used for network communication.
+	//
+	// Type: string
+	// Examples: "1.1", "2"
+	// Stability: stable
+	OtelNetworkProtocolVersion = "network.protocol.version"
+
+	// [OSI transport layer](https://osi-model.com/transport-layer/) or [inter-process communication
+	// method](https://wikipedia.org/wiki/Inter-process_communication).
+	//
+	// Type: string
+	// Enum: tcp, udp, pipe, unix, quic
+	// Examples: "tcp", "udp"
+	// Stability: stable
+	OtelNetworkTransport = "network.transport"
+
+	// [OSI network layer](https://osi-model.com/network-layer/) or non-OSI equivalent.
+	//
+	// Type: string
+	// Enum: ipv4, ipv6
+	// Examples: "ipv4", "ipv6"
+	// Stability: stable
+	OtelNetworkType = "network.type"
+
+	// The [error codes](https://connect.build/docs/protocol/#error-codes) of the Connect request.
+	// Error codes are always string values.
+	//
+	// Type: string
+	// Enum: cancelled, unknown, invalid_argument, deadline_exceeded, not_found, already_exists,
+	// permission_denied, resource_exhausted, failed_precondition, aborted, out_of_range,
+	// unimplemented, internal, unavailable, data_loss, unauthenticated
+	// Stability: experimental
+	OtelRPCConnectRPCErrorCode = "rpc.connect_rpc.error_code"
+
+	// The [numeric status code](https://github.com/grpc/grpc/blob/v1.33.2/doc/statuscodes.md) of the
+	// gRPC request.
+	//
+	// Type: int
+	// Enum: 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16
+	// Stability: experimental
+	OtelRPCGRPCStatusCode = "rpc.grpc.status_code"
+
+	// `error.code` property of response if it is an error response.
+	//
+	// Type: int
+	// Examples: -32700, 100
+	// Stability: experimental
+	OtelRPCJsonrpcErrorCode = "rpc.jsonrpc.error_code"
+
+	// `error.message` property of response if it is an error response.
+	//
+	// Type: string
+	// Examples: "Parse error", "User already exists"
+	// Stability: experimental
+	OtelRPCJsonrpcErrorMessage = "rpc.jsonrpc.error_message"
+
+	// `id` property of request or response. Since protocol allows id to be int, string, `null` or
+	// missing (for notifications), value is expected to be cast to string for simplicity. Use empty
+	// string in case of `null` value. Omit entirely if this is a notification.
+	//
+	// Type: string
+	// Examples: "10", "request-7", ""
+	// Stability: experimental
+	OtelRPCJsonrpcRequestID = "rpc.jsonrpc.request_id"
+
+	// Protocol version as in `jsonrpc` property of request/response. Since JSON-RPC 1.0 doesn't
+	// specify this, the value can be omitted.
+	//
+	// Type: string
+	// Examples: "2.0", "1.0"
+	// Stability: experimental
+	OtelRPCJsonrpcVersion = "rpc.jsonrpc.version"
+
+	// Compressed size of the message in bytes.
+	//
+	// Type: int
+	// Stability: experimental
+	OtelRPCMessageCompressedSize = "rpc.message.compressed_size"
+
+	// MUST be calculated as two different counters starting from `1` one for sent messages and one for
+	// received message.
+	//
+	// Type: int
+	// Stability: experimental
+	OtelRPCMessageID = "rpc.message.id"
+
+	// Whether this is a received or sent message.
+	//
+	// Type: string
+	// Enum: SENT, RECEIVED
+	// Stability: experimental
+	OtelRPCMessageType = "rpc.message.type"
+
+	// Uncompressed size of the message in bytes.
+	//
+	// Type: int
+	// Stability: experimental
+	OtelRPCMessageUncompressedSize = "rpc.message.uncompressed_size"
+
+	// The name of the (logical) method being called, must be equal to the $method part in the span
+	// name.
+	//
+	// Type: string
+	// Examples: "exampleMethod"
+	// Stability: experimental
+	OtelRPCMethod = "rpc.method"
+
+	// The full (logical) name of the service being called, including its package name, if applicable.
+	//
+	// Type: string
+	// Examples: "myservice.EchoService"
+	// Stability: experimental
+	OtelRPCService = "rpc.service"
+
+	// A string identifying the remoting system. See below for a list of well-known identifiers.
+	//
+	// Type: string
+	// Enum: grpc, java_rmi, dotnet_wcf, apache_dubbo, connect_rpc
+	// Stability: experimental
+	OtelRPCSystem = "rpc.system"
+
+	// Server domain name if available without reverse DNS lookup; otherwise, IP address or Unix domain
+	// socket name.
+	//
+	// Type: string
+	// Examples: "example.com", "10.1.2.80", "/tmp/my.sock"
+	// Stability: stable
+	OtelServerAddress = "server.address"
+
+	// Server port number.
+	//
+	// Type: int
+	// Examples: 80, 8080, 443
+	// Stability: stable
+	OtelServerPort = "server.port"
+
+	// The string ID of the service instance.
+	//
+	// Type: string
+	// Examples: "627cc493-f310-47de-96bd-71410b7dec09"
+	// Stability: experimental
+	OtelServiceInstanceID = "service.instance.id"
+
+	// Logical name of the service.
+	//
+	// Type: string
+	// Examples: "shoppingcart"
+	// Stability: stable
+	OtelServiceName = "service.name"
+
+	// A namespace for `service.name`.
+	//
+	// Type: string
+	// Examples: "Shop"
+	// Stability: experimental
+	OtelServiceNamespace = "service.namespace"
+
+	// The version string of the service API or implementation. The format is not defined by these
+	// conventions.
+	//
+	// Type: string
+	// Examples: "2.0.0", "a01dbef8a"
+	// Stability: stable
+	OtelServiceVersion = "service.version"
+
+	// A unique id to identify a session.
+	//
+	// Type: string
+	// Examples: "00112233-4455-6677-8899-aabbccddeeff"
+	// Stability: experimental
+	OtelSessionID = "session.id"
+
+	// The previous `session.id` for this user, when known.
+	//
+	// Type: string
+	// Examples: "00112233-4455-6677-8899-aabbccddeeff"
+	// Stability: experimental
+	OtelSessionPreviousID = "session.previous_id"
+
+	// String indicating the [cipher](https://datatracker.ietf.org/doc/html/rfc5246#appendix-A.5) used
+	// during the current connection.
+	//
+	// Type: string
+	// Examples: "TLS_RSA_WITH_3DES_EDE_CBC_SHA", "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256"
+	// Stability: experimental
+	OtelTLSCipher = "tls.cipher"
+
+	// PEM-encoded stand-alone certificate offered by the client. This is usually mutually-exclusive of
+	// `client.certificate_chain` since this value also exists in that list.
+	//
+	// Type: string
+	// Examples: "MII..."
+	// Stability: experimental
+	OtelTLSClientCertificate = "tls.client.certificate"
+
+	// Array of PEM-encoded certificates that make up the certificate chain offered by the client. This
+	// is usually mutually-exclusive of `client.certificate` since that value should be the first
+	// certificate in the chain.
+	//
+	// Type: string[]
+	// Examples: "MII...", "MI..."
+	// Stability: experimental
+	OtelTLSClientCertificateChain = "tls.client.certificate_chain"
+
+	// Certificate fingerprint using the MD5 digest of DER-encoded version of certificate offered by
+	// the client. For consistency with other hash values, this value should be formatted as an
+	// uppercase hash.
+	//
+	// Type: string
+	// Examples: "0F76C7F2C55BFD7D8E8B8F4BFBF0C9EC"
+	// Stability: experimental
+	OtelTLSClientHashMd5 = "tls.client.hash.md5"
+
+	// Certificate fingerprint using the SHA1 digest of DER-encoded version of certificate offered by
+	// the client. For consistency with other hash values, this value should be formatted as an
+	// uppercase hash.
+	//
+	// Type: string
+	// Examples: "9E393D93138888D288266C2D915214D1D1CCEB2A"
+	// Stability: experimental
+	OtelTLSClientHashSha1 = "tls.client.hash.sha1"
+
+	// Certificate fingerprint using the SHA256 digest of DER-encoded version of certificate offered by
+	// the client. For consistency with other hash values, this value should be formatted as an
+	// uppercase hash.
+	//
+	// Type: string
+	// Examples: "0687F666A054EF17A08E2F2162EAB4CBC0D265E1D7875BE74BF3C712CA92DAF0"
+	// Stability: experimental
+	OtelTLSClientHashSha256 = "tls.client.hash.sha256"
+
+	// Distinguished name of [subject](https://datatracker.ietf.org/doc/html/rfc5280#section-4.1.2.6)
+	// of the issuer of the x.509 certificate presented by the client.
+	//
+	// Type: string
+	// Examples: "CN=Example Root CA, OU=Infrastructure Team, DC=example, DC=com"
+	// Stability: experimental
+	OtelTLSClientIssuer = "tls.client.issuer"
+
+	// A hash that identifies clients based on how they perform an SSL/TLS handshake.
+	//
+	// Type: string
+	// Examples: "d4e5b18d6b55c71272893221c96ba240"
+	// Stability: experimental
+	OtelTLSClientJa3 = "tls.client.ja3"
+
+	// Date/Time indicating when client certificate is no longer considered valid.
+	//
+	// Type: string
+	// Examples: "2021-01-01T00:00:00.000Z"
+	// Stability: experimental
+	OtelTLSClientNotAfter = "tls.client.not_after"
+
+	// Date/Time indicating when client certificate is first considered valid.
+	//
+	// Type: string
+	// Examples: "1970-01-01T00:00:00.000Z"
+	// Stability: experimental
+	OtelTLSClientNotBefore = "tls.client.not_before"
+
+	// Distinguished name of subject of the x.509 certificate presented by the client.
+	//
+	// Type: string
+	// Examples: "CN=myclient, OU=Documentation Team, DC=example, DC=com"
+	// Stability: experimental
+	OtelTLSClientSubject = "tls.client.subject"
+
+	// Array of ciphers offered by the client during the client hello.
+	//
+	// Type: string[]
+	// Examples: "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	// "..."
+	// Stability: experimental
+	OtelTLSClientSupportedCiphers = "tls.client.supported_ciphers"
+
+	// String indicating the curve used for the given cipher, when applicable
+	//
+	// Type: string
+	// Examples: "secp256r1"
+	// Stability: experimental
+	OtelTLSCurve = "tls.curve"
+
+	// Boolean flag indicating if the TLS negotiation was successful and transitioned to an encrypted
+	// tunnel.
+	//
+	// Type: boolean
+	// Examples: true
+	// Stability: experimental
+	OtelTLSEstablished = "tls.established"
+
+	// String indicating the protocol being tunneled. Per the values in the [IANA
+	// registry](https://www.iana.org/assignments/tls-extensiontype-values/tls-extensiontype-values.xhtml#alpn-protocol-ids),
+	// this string should be lower case.
+	//
+	// Type: string
+	// Examples: "http/1.1"
+	// Stability: experimental
+	OtelTLSNextProtocol = "tls.next_protocol"
+
+	// Normalized lowercase protocol name parsed from original string of the negotiated [SSL/TLS
+	// protocol version](https://www.openssl.org/docs/man1.1.1/man3/SSL_get_version.html#RETURN-VALUES)
+	//
+	// Type: string
+	// Enum: ssl, tls
+	// Stability: experimental
+	OtelTLSProtocolName = "tls.protocol.name"
+
+	// Numeric part of the version parsed from the original string of the negotiated [SSL/TLS protocol
+	// version](https://www.openssl.org/docs/man1.1.1/man3/SSL_get_version.html#RETURN-VALUES)
+	//
+	// Type: string
+	// Examples: "1.2", "3"
+	// Stability: experimental
+	OtelTLSProtocolVersion = "tls.protocol.version"
+
+	// Boolean flag indicating if this TLS connection was resumed from an existing TLS negotiation.
+	//
+	// Type: boolean
+	// Examples: true
+	// Stability: experimental
+	OtelTLSResumed = "tls.resumed"
+
+	// PEM-encoded stand-alone certificate offered by the server. This is usually mutually-exclusive of
+	// `server.certificate_chain` since this value also exists in that list.
+	//
+	// Type: string
+	// Examples: "MII..."
+	// Stability: experimental
+	OtelTLSServerCertificate = "tls.server.certificate"
+
+	// Array of PEM-encoded certificates that make up the certificate chain offered by the server. This
+	// is usually mutually-exclusive of `server.certificate` since that value should be the first
+	// certificate in the chain.
+	//
+	// Type: string[]
+	// Examples: "MII...", "MI..."
+	// Stability: experimental
+	OtelTLSServerCertificateChain = "tls.server.certificate_chain"
+
+	// Certificate fingerprint using the MD5 digest of DER-encoded version of certificate offered by
+	// the server. For consistency with other hash values, this value should be formatted as an
+	// uppercase hash.
+	//
+	// Type: string
+	// Examples: "0F76C7F2C55BFD7D8E8B8F4BFBF0C9EC"
+	// Stability: experimental
+	OtelTLSServerHashMd5 = "tls.server.hash.md5"
+
+	// Certificate fingerprint using the SHA1 digest of DER-encoded version of certificate offered by
+	// the server. For consistency with other hash values, this value should be formatted as an
+	// uppercase hash.
+	//
+	// Type: string
+	// Examples: "9E393D93138888D288266C2D915214D1D1CCEB2A"
+	// Stability: experimental
+	OtelTLSServerHashSha1 = "tls.server.hash.sha1"
+
+	// Certificate fingerprint using the SHA256 digest of DER-encoded version of certificate offered by
+	// the server. For consistency with other hash values, this value should be formatted as an
+	// uppercase hash.
+	//
+	// Type: string
+	// Examples: "0687F666A054EF17A08E2F2162EAB4CBC0D265E1D7875BE74BF3C712CA92DAF0"
+	// Stability: experimental
+	OtelTLSServerHashSha256 = "tls.server.hash.sha256"
+
+	// Distinguished name of [subject](https://datatracker.ietf.org/doc/html/rfc5280#section-4.1.2.6)
+	// of the issuer of the x.509 certificate presented by the client.
+	//
+	// Type: string
+	// Examples: "CN=Example Root CA, OU=Infrastructure Team, DC=example, DC=com"
+	// Stability: experimental
+	OtelTLSServerIssuer = "tls.server.issuer"
+
+	// A hash that identifies servers based on how they perform an SSL/TLS handshake.
+	//
+	// Type: string
+	// Examples: "d4e5b18d6b55c71272893221c96ba240"
+	// Stability: experimental
+	OtelTLSServerJa3s = "tls.server.ja3s"
+
+	// Date/Time indicating when server certificate is no longer considered valid.
+	//
+	// Type: string
+	// Examples: "2021-01-01T00:00:00.000Z"
+	// Stability: experimental
+	OtelTLSServerNotAfter = "tls.server.not_after"
+
+	// Date/Time indicating when server certificate is first considered valid.
+	//
+	// Type: string
+	// Examples: "1970-01-01T00:00:00.000Z"
+	// Stability: experimental
+	OtelTLSServerNotBefore = "tls.server.not_before"
+
+	// Distinguished name of subject of the x.509 certificate presented by the server.
+	//
+	// Type: string
+	// Examples: "CN=myserver, OU=Documentation Team, DC=example, DC=com"
+	// Stability: experimental
+	OtelTLSServerSubject = "tls.server.subject"
+
+	// Domain extracted from the `url.full`, such as "opentelemetry.io".
+	//
+	// Type: string
+	// Examples: "www.foo.bar", "opentelemetry.io", "3.12.167.2", "[1080:0:0:0:8:800:200C:417A]"
+	// Stability: experimental
+	OtelURLDomain = "url.domain"
+
+	// The file extension extracted from the `url.full`, excluding the leading dot.
+	//
+	// Type: string
+	// Examples: "png", "gz"
+	// Stability: experimental
+	OtelURLExtension = "url.extension"
+
+	// The [URI fragment](https://www.rfc-editor.org/rfc/rfc3986#section-3.5) component
+	//
+	// Type: string
+	// Examples: "SemConv"
+	// Stability: stable
+	OtelURLFragment = "url.fragment"
+
+	// Absolute URL describing a network resource according to
+	// [RFC3986](https://www.rfc-editor.org/rfc/rfc3986)
+	//
+	// Type: string
+	// Examples: "https://www.foo.bar/search?q=OpenTelemetry#SemConv", "//localhost"
+	// Stability: stable
+	OtelURLFull = "url.full"
+
+	// Unmodified original URL as seen in the event source.
+	//
+	// Type: string
+	// Examples: "https://www.foo.bar/search?q=OpenTelemetry#SemConv", "search?q=OpenTelemetry"
+	// Stability: experimental
+	OtelURLOriginal = "url.original"
+
+	// The [URI path](https://www.rfc-editor.org/rfc/rfc3986#section-3.3) component
+	//
+	// Type: string
+	// Examples: "/search"
+	// Stability: stable
+	OtelURLPath = "url.path"
+
+	// Port extracted from the `url.full`
+	//
+	// Type: int
+	// Examples: 443
+	// Stability: experimental
+	OtelURLPort = "url.port"
+
+	// The [URI query](https://www.rfc-editor.org/rfc/rfc3986#section-3.4) component
+	//
+	// Type: string
+	// Examples: "q=OpenTelemetry"
+	// Stability: stable
+	OtelURLQuery = "url.query"
+
+	// The highest registered url domain, stripped of the subdomain.
+	//
+	// Type: string
+	// Examples: "example.com", "foo.co.uk"
+	// Stability: experimental
+	OtelURLRegisteredDomain = "url.registered_domain"
+
+	// The [URI scheme](https://www.rfc-editor.org/rfc/rfc3986#section-3.1) component identifying the
+	// used protocol.
+	//
+	// Type: string
+	// Examples: "https", "ftp", "telnet"
+	// Stability: stable
+	OtelURLScheme = "url.scheme"
+
+	// The subdomain portion of a fully qualified domain name includes all of the names except the host
+	// name under the registered_domain. In a partially qualified domain, or if the qualification level
+	// of the full name cannot be determined, subdomain contains all of the names below the registered
+	// domain.
+	//
+	// Type: string
+	// Examples: "east", "sub2.sub1"
+	// Stability: experimental
+	OtelURLSubdomain = "url.subdomain"
+
+	// The low-cardinality template of an [absolute path
+	// reference](https://www.rfc-editor.org/rfc/rfc3986#section-4.2).
+	//
+	// Type: string
+	// Examples: "/users/{id}", "/users/:id", "/users?id={id}"
+	// Stability: experimental
+	OtelURLTemplate = "url.template"
+
+	// The effective top level domain (eTLD), also known as the domain suffix, is the last part of the
+	// domain name. For example, the top level domain for example.com is `com`.
+	//
+	// Type: string
+	// Examples: "com", "co.uk"
+	// Stability: experimental
+	OtelURLTopLevelDomain = "url.top_level_domain"
+
+	// User email address.
+	//
+	// Type: string
+	// Examples: "a.einstein@example.com"
+	// Stability: experimental
+	OtelUserEmail = "user.email"
+
+	// User's full name
+	//
+	// Type: string
+	// Examples: "Albert Einstein"
+	// Stability: experimental
+	OtelUserFullName = "user.full_name"
+
+	// Unique user hash to correlate information for a user in anonymized form.
+	//
+	// Type: string
+	// Examples: "364fc68eaf4c8acec74a4e52d7d1feaa"
+	// Stability: experimental
+	OtelUserHash = "user.hash"
+
+	// Unique identifier of the user.
+	//
+	// Type: string
+	// Examples: "S-1-5-21-202424912787-2692429404-2351956786-1000"
+	// Stability: experimental
+	OtelUserID = "user.id"
+
+	// Short name or login/username of the user.
+	//
+	// Type: string
+	// Examples: "a.einstein"
+	// Stability: experimental
+	OtelUserName = "user.name"
+
+	// Array of user roles at the time of the event.
+	//
+	// Type: string[]
+	// Examples: "admin", "reporting_user"
+	// Stability: experimental
+	OtelUserRoles = "user.roles"
+
+	// Name of the user-agent extracted from original. Usually refers to the browser's name.
+	//
+	// Type: string
+	// Examples: "Safari", "YourApp"
+	// Stability: experimental
+	OtelHTTPUserAgentName = "user_agent.name"
+
+	// Value of the [HTTP User-Agent](https://www.rfc-editor.org/rfc/rfc9110.html#field.user-agent)
+	// header sent by the client.
+	//
+	// Type: string
+	// Examples: "CERN-LineMode/2.15 libwww/2.17b3", "Mozilla/5.0 (iPhone; CPU iPhone OS 14_7_1 like
+	// Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.1.2 Mobile/15E148 Safari/604.1",
+	// "YourApp/1.0.0 grpc-java-okhttp/1.27.2"
+	// Stability: stable
+	OtelUserAgentOriginal = "user_agent.original"
+
+	// Version of the user-agent extracted from original. Usually refers to the browser's version
+	//
+	// Type: string
+	// Examples: "14.1.2", "1.0.0"
+	// Stability: experimental
+	OtelUserAgentVersion = "user_agent.version"
 )
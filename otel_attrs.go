@@ -0,0 +1,770 @@
+// Code generated by semconvgen from semantic conventions v1.27.0. DO NOT EDIT.
+
+package errors
+
+import "log/slog"
+
+// ClientAddress returns the OtelClientAddress and OtelClientPort attributes
+func ClientAddress(address string, port int) slog.Attr {
+	return slog.Group("", slog.String(OtelClientAddress, address), slog.Int(OtelClientPort, port))
+}
+
+// ClientPort returns the OtelClientPort attribute
+func ClientPort(v int) slog.Attr {
+	return slog.Int(OtelClientPort, v)
+}
+
+// CodeColumn returns the OtelCodeColumn attribute
+func CodeColumn(v int) slog.Attr {
+	return slog.Int(OtelCodeColumn, v)
+}
+
+// CodeFilePath returns the OtelCodeFilePath attribute
+func CodeFilePath(v string) slog.Attr {
+	return slog.String(OtelCodeFilePath, v)
+}
+
+// CodeFunction returns the OtelCodeFunction attribute
+func CodeFunction(v string) slog.Attr {
+	return slog.String(OtelCodeFunction, v)
+}
+
+// CodeLineNo returns the OtelCodeLineNo attribute
+func CodeLineNo(v int) slog.Attr {
+	return slog.Int(OtelCodeLineNo, v)
+}
+
+// CodeNamespace returns the OtelCodeNamespace attribute
+func CodeNamespace(v string) slog.Attr {
+	return slog.String(OtelCodeNamespace, v)
+}
+
+// CodeStacktrace returns the OtelCodeStacktrace attribute
+func CodeStacktrace(v string) slog.Attr {
+	return slog.String(OtelCodeStacktrace, v)
+}
+
+// DBClientConnectionPoolName returns the OtelDBClientConnectionPoolName attribute
+func DBClientConnectionPoolName(v string) slog.Attr {
+	return slog.String(OtelDBClientConnectionPoolName, v)
+}
+
+// DBClientConnectionState returns the OtelDBClientConnectionState attribute
+func DBClientConnectionState(v string) slog.Attr {
+	return slog.String(OtelDBClientConnectionState, v)
+}
+
+// DBCollectionName returns the OtelDBCollectionName attribute
+func DBCollectionName(v string) slog.Attr {
+	return slog.String(OtelDBCollectionName, v)
+}
+
+// DBNamespace returns the OtelDBNamespace attribute
+func DBNamespace(v string) slog.Attr {
+	return slog.String(OtelDBNamespace, v)
+}
+
+// DBOperationBatchSize returns the OtelDBOperationBatchSize attribute
+func DBOperationBatchSize(v int) slog.Attr {
+	return slog.Int(OtelDBOperationBatchSize, v)
+}
+
+// DBOperationName returns the OtelDBOperationName attribute
+func DBOperationName(v string) slog.Attr {
+	return slog.String(OtelDBOperationName, v)
+}
+
+// DBQueryText returns the OtelDBQueryText attribute
+func DBQueryText(v string) slog.Attr {
+	return slog.String(OtelDBQueryText, v)
+}
+
+// DBSystem returns the OtelDBSystem attribute
+func DBSystem(v string) slog.Attr {
+	return slog.String(OtelDBSystem, v)
+}
+
+// ErrorType returns the OtelErrorType attribute
+func ErrorType(v string) slog.Attr {
+	return slog.String(OtelErrorType, v)
+}
+
+// ExceptionEscaped returns the OtelExceptionEscaped attribute
+func ExceptionEscaped(v bool) slog.Attr {
+	return slog.Bool(OtelExceptionEscaped, v)
+}
+
+// ExceptionMessage returns the OtelExceptionMessage attribute
+func ExceptionMessage(v string) slog.Attr {
+	return slog.String(OtelExceptionMessage, v)
+}
+
+// ExceptionStacktrace returns the OtelExceptionStacktrace attribute
+func ExceptionStacktrace(v string) slog.Attr {
+	return slog.String(OtelExceptionStacktrace, v)
+}
+
+// ExceptionType returns the OtelExceptionType attribute
+func ExceptionType(v string) slog.Attr {
+	return slog.String(OtelExceptionType, v)
+}
+
+// FileDirectory returns the OtelFileDirectory attribute
+func FileDirectory(v string) slog.Attr {
+	return slog.String(OtelFileDirectory, v)
+}
+
+// FileExtension returns the OtelFileExtension attribute
+func FileExtension(v string) slog.Attr {
+	return slog.String(OtelFileExtension, v)
+}
+
+// FileName returns the OtelFileName attribute
+func FileName(v string) slog.Attr {
+	return slog.String(OtelFileName, v)
+}
+
+// FilePath returns the OtelFilePath attribute
+func FilePath(v string) slog.Attr {
+	return slog.String(OtelFilePath, v)
+}
+
+// FileSize returns the OtelFileSize attribute
+func FileSize(v int) slog.Attr {
+	return slog.Int(OtelFileSize, v)
+}
+
+// HostArch returns the OtelHostArch attribute
+func HostArch(v string) slog.Attr {
+	return slog.String(OtelHostArch, v)
+}
+
+// HostCPUCacheL2Size returns the OtelHostCPUCacheL2Size attribute
+func HostCPUCacheL2Size(v int) slog.Attr {
+	return slog.Int(OtelHostCPUCacheL2Size, v)
+}
+
+// HostCPUFamily returns the OtelHostCPUFamily attribute
+func HostCPUFamily(v string) slog.Attr {
+	return slog.String(OtelHostCPUFamily, v)
+}
+
+// HostCPUModelID returns the OtelHostCPUModelID attribute
+func HostCPUModelID(v string) slog.Attr {
+	return slog.String(OtelHostCPUModelID, v)
+}
+
+// HostCPUModelName returns the OtelHostCPUModelName attribute
+func HostCPUModelName(v string) slog.Attr {
+	return slog.String(OtelHostCPUModelName, v)
+}
+
+// HostCPUStepping returns the OtelHostCPUStepping attribute
+func HostCPUStepping(v string) slog.Attr {
+	return slog.String(OtelHostCPUStepping, v)
+}
+
+// HostCPUVendorID returns the OtelHostCPUVendorID attribute
+func HostCPUVendorID(v string) slog.Attr {
+	return slog.String(OtelHostCPUVendorID, v)
+}
+
+// HostID returns the OtelHostID attribute
+func HostID(v string) slog.Attr {
+	return slog.String(OtelHostID, v)
+}
+
+// HostImageID returns the OtelHostImageID attribute
+func HostImageID(v string) slog.Attr {
+	return slog.String(OtelHostImageID, v)
+}
+
+// HostImageName returns the OtelHostImageName attribute
+func HostImageName(v string) slog.Attr {
+	return slog.String(OtelHostImageName, v)
+}
+
+// HostImageVersion returns the OtelHostImageVersion attribute
+func HostImageVersion(v string) slog.Attr {
+	return slog.String(OtelHostImageVersion, v)
+}
+
+// HostIP returns the OtelHostIP attribute
+func HostIP(v ...string) slog.Attr {
+	return slog.Any(OtelHostIP, v)
+}
+
+// HostMac returns the OtelHostMac attribute
+func HostMac(v ...string) slog.Attr {
+	return slog.Any(OtelHostMac, v)
+}
+
+// HostName returns the OtelHostName attribute
+func HostName(v string) slog.Attr {
+	return slog.String(OtelHostName, v)
+}
+
+// HostType returns the OtelHostType attribute
+func HostType(v string) slog.Attr {
+	return slog.String(OtelHostType, v)
+}
+
+// HTTPConnectionState returns the OtelHTTPConnectionState attribute
+func HTTPConnectionState(v string) slog.Attr {
+	return slog.String(OtelHTTPConnectionState, v)
+}
+
+// HTTPRequestBodySize returns the OtelHTTPRequestBodySize attribute
+func HTTPRequestBodySize(v int) slog.Attr {
+	return slog.Int(OtelHTTPRequestBodySize, v)
+}
+
+// HTTPRequestMethod returns the OtelHTTPRequestMethod attribute
+func HTTPRequestMethod(v string) slog.Attr {
+	return slog.String(OtelHTTPRequestMethod, v)
+}
+
+// HTTPRequestMethodOriginal returns the OtelHTTPRequestMethodOriginal attribute
+func HTTPRequestMethodOriginal(v string) slog.Attr {
+	return slog.String(OtelHTTPRequestMethodOriginal, v)
+}
+
+// HTTPRequestResendCount returns the OtelHTTPRequestResendCount attribute
+func HTTPRequestResendCount(v int) slog.Attr {
+	return slog.Int(OtelHTTPRequestResendCount, v)
+}
+
+// HTTPRequestSize returns the OtelHTTPRequestSize attribute
+func HTTPRequestSize(v int) slog.Attr {
+	return slog.Int(OtelHTTPRequestSize, v)
+}
+
+// HTTPResponseBodySize returns the OtelHTTPResponseBodySize attribute
+func HTTPResponseBodySize(v int) slog.Attr {
+	return slog.Int(OtelHTTPResponseBodySize, v)
+}
+
+// HTTPResponseSize returns the OtelHTTPResponseSize attribute
+func HTTPResponseSize(v int) slog.Attr {
+	return slog.Int(OtelHTTPResponseSize, v)
+}
+
+// HTTPResponseStatusCode returns the OtelHTTPResponseStatusCode attribute
+func HTTPResponseStatusCode(v int) slog.Attr {
+	return slog.Int(OtelHTTPResponseStatusCode, v)
+}
+
+// HTTPRoute returns the OtelHTTPRoute attribute
+func HTTPRoute(v string) slog.Attr {
+	return slog.String(OtelHTTPRoute, v)
+}
+
+// MessagingBatchMessageCount returns the OtelMessagingBatchMessageCount attribute
+func MessagingBatchMessageCount(v int) slog.Attr {
+	return slog.Int(OtelMessagingBatchMessageCount, v)
+}
+
+// MessagingClientID returns the OtelMessagingClientID attribute
+func MessagingClientID(v string) slog.Attr {
+	return slog.String(OtelMessagingClientID, v)
+}
+
+// MessagingConsumerGroupName returns the OtelMessagingConsumerGroupName attribute
+func MessagingConsumerGroupName(v string) slog.Attr {
+	return slog.String(OtelMessagingConsumerGroupName, v)
+}
+
+// MessagingDestinationAnonymous returns the OtelMessagingDestinationAnonymous attribute
+func MessagingDestinationAnonymous(v bool) slog.Attr {
+	return slog.Bool(OtelMessagingDestinationAnonymous, v)
+}
+
+// MessagingDestinationName returns the OtelMessagingDestinationName attribute
+func MessagingDestinationName(v string) slog.Attr {
+	return slog.String(OtelMessagingDestinationName, v)
+}
+
+// MessagingDestinationPartitionID returns the OtelMessagingDestinationPartitionID attribute
+func MessagingDestinationPartitionID(v string) slog.Attr {
+	return slog.String(OtelMessagingDestinationPartitionID, v)
+}
+
+// MessagingDestinationSubscriptionName returns the OtelMessagingDestinationSubscriptionName attribute
+func MessagingDestinationSubscriptionName(v string) slog.Attr {
+	return slog.String(OtelMessagingDestinationSubscriptionName, v)
+}
+
+// MessagingDestinationTemplate returns the OtelMessagingDestinationTemplate attribute
+func MessagingDestinationTemplate(v string) slog.Attr {
+	return slog.String(OtelMessagingDestinationTemplate, v)
+}
+
+// MessagingDestinationTemporary returns the OtelMessagingDestinationTemporary attribute
+func MessagingDestinationTemporary(v bool) slog.Attr {
+	return slog.Bool(OtelMessagingDestinationTemporary, v)
+}
+
+// MessagingMessageBodySize returns the OtelMessagingMessageBodySize attribute
+func MessagingMessageBodySize(v int) slog.Attr {
+	return slog.Int(OtelMessagingMessageBodySize, v)
+}
+
+// MessagingMessageConversationID returns the OtelMessagingMessageConversationID attribute
+func MessagingMessageConversationID(v string) slog.Attr {
+	return slog.String(OtelMessagingMessageConversationID, v)
+}
+
+// MessagingMessageEnvelopeSize returns the OtelMessagingMessageEnvelopeSize attribute
+func MessagingMessageEnvelopeSize(v int) slog.Attr {
+	return slog.Int(OtelMessagingMessageEnvelopeSize, v)
+}
+
+// MessagingMessageID returns the OtelMessagingMessageID attribute
+func MessagingMessageID(v string) slog.Attr {
+	return slog.String(OtelMessagingMessageID, v)
+}
+
+// MessagingOperationName returns the OtelMessagingOperationName attribute
+func MessagingOperationName(v string) slog.Attr {
+	return slog.String(OtelMessagingOperationName, v)
+}
+
+// MessagingOperationType returns the OtelMessagingOperationType attribute
+func MessagingOperationType(v string) slog.Attr {
+	return slog.String(OtelMessagingOperationType, v)
+}
+
+// MessagingSystem returns the OtelMessagingSystem attribute
+func MessagingSystem(v string) slog.Attr {
+	return slog.String(OtelMessagingSystem, v)
+}
+
+// NetworkCarrierIcc returns the OtelNetworkCarrierIcc attribute
+func NetworkCarrierIcc(v string) slog.Attr {
+	return slog.String(OtelNetworkCarrierIcc, v)
+}
+
+// NetworkCarrierMcc returns the OtelNetworkCarrierMcc attribute
+func NetworkCarrierMcc(v string) slog.Attr {
+	return slog.String(OtelNetworkCarrierMcc, v)
+}
+
+// NetworkCarrierMnc returns the OtelNetworkCarrierMnc attribute
+func NetworkCarrierMnc(v string) slog.Attr {
+	return slog.String(OtelNetworkCarrierMnc, v)
+}
+
+// NetworkCarrierName returns the OtelNetworkCarrierName attribute
+func NetworkCarrierName(v string) slog.Attr {
+	return slog.String(OtelNetworkCarrierName, v)
+}
+
+// NetworkConnectionSubtype returns the OtelNetworkConnectionSubtype attribute
+func NetworkConnectionSubtype(v string) slog.Attr {
+	return slog.String(OtelNetworkConnectionSubtype, v)
+}
+
+// NetworkConnectionType returns the OtelNetworkConnectionType attribute
+func NetworkConnectionType(v string) slog.Attr {
+	return slog.String(OtelNetworkConnectionType, v)
+}
+
+// NetworkIODirection returns the OtelNetworkIODirection attribute
+func NetworkIODirection(v string) slog.Attr {
+	return slog.String(OtelNetworkIODirection, v)
+}
+
+// NetworkLocalAddress returns the OtelNetworkLocalAddress and OtelNetworkLocalPort attributes
+func NetworkLocalAddress(address string, port int) slog.Attr {
+	return slog.Group("", slog.String(OtelNetworkLocalAddress, address), slog.Int(OtelNetworkLocalPort, port))
+}
+
+// NetworkLocalPort returns the OtelNetworkLocalPort attribute
+func NetworkLocalPort(v int) slog.Attr {
+	return slog.Int(OtelNetworkLocalPort, v)
+}
+
+// NetworkPeerAddress returns the OtelNetworkPeerAddress and OtelNetworkPeerPort attributes
+func NetworkPeerAddress(address string, port int) slog.Attr {
+	return slog.Group("", slog.String(OtelNetworkPeerAddress, address), slog.Int(OtelNetworkPeerPort, port))
+}
+
+// NetworkPeerPort returns the OtelNetworkPeerPort attribute
+func NetworkPeerPort(v int) slog.Attr {
+	return slog.Int(OtelNetworkPeerPort, v)
+}
+
+// NetworkProtocolName returns the OtelNetworkProtocolName attribute
+func NetworkProtocolName(v string) slog.Attr {
+	return slog.String(OtelNetworkProtocolName, v)
+}
+
+// NetworkProtocolVersion returns the OtelNetworkProtocolVersion attribute
+func NetworkProtocolVersion(v string) slog.Attr {
+	return slog.String(OtelNetworkProtocolVersion, v)
+}
+
+// NetworkTransport returns the OtelNetworkTransport attribute
+func NetworkTransport(v string) slog.Attr {
+	return slog.String(OtelNetworkTransport, v)
+}
+
+// NetworkType returns the OtelNetworkType attribute
+func NetworkType(v string) slog.Attr {
+	return slog.String(OtelNetworkType, v)
+}
+
+// RPCConnectRPCErrorCode returns the OtelRPCConnectRPCErrorCode attribute
+func RPCConnectRPCErrorCode(v string) slog.Attr {
+	return slog.String(OtelRPCConnectRPCErrorCode, v)
+}
+
+// RPCGRPCStatusCode returns the OtelRPCGRPCStatusCode attribute
+func RPCGRPCStatusCode(v int) slog.Attr {
+	return slog.Int(OtelRPCGRPCStatusCode, v)
+}
+
+// RPCJsonrpcErrorCode returns the OtelRPCJsonrpcErrorCode attribute
+func RPCJsonrpcErrorCode(v int) slog.Attr {
+	return slog.Int(OtelRPCJsonrpcErrorCode, v)
+}
+
+// RPCJsonrpcErrorMessage returns the OtelRPCJsonrpcErrorMessage attribute
+func RPCJsonrpcErrorMessage(v string) slog.Attr {
+	return slog.String(OtelRPCJsonrpcErrorMessage, v)
+}
+
+// RPCJsonrpcRequestID returns the OtelRPCJsonrpcRequestID attribute
+func RPCJsonrpcRequestID(v string) slog.Attr {
+	return slog.String(OtelRPCJsonrpcRequestID, v)
+}
+
+// RPCJsonrpcVersion returns the OtelRPCJsonrpcVersion attribute
+func RPCJsonrpcVersion(v string) slog.Attr {
+	return slog.String(OtelRPCJsonrpcVersion, v)
+}
+
+// RPCMessageCompressedSize returns the OtelRPCMessageCompressedSize attribute
+func RPCMessageCompressedSize(v int) slog.Attr {
+	return slog.Int(OtelRPCMessageCompressedSize, v)
+}
+
+// RPCMessageID returns the OtelRPCMessageID attribute
+func RPCMessageID(v int) slog.Attr {
+	return slog.Int(OtelRPCMessageID, v)
+}
+
+// RPCMessageType returns the OtelRPCMessageType attribute
+func RPCMessageType(v string) slog.Attr {
+	return slog.String(OtelRPCMessageType, v)
+}
+
+// RPCMessageUncompressedSize returns the OtelRPCMessageUncompressedSize attribute
+func RPCMessageUncompressedSize(v int) slog.Attr {
+	return slog.Int(OtelRPCMessageUncompressedSize, v)
+}
+
+// RPCMethod returns the OtelRPCMethod attribute
+func RPCMethod(v string) slog.Attr {
+	return slog.String(OtelRPCMethod, v)
+}
+
+// RPCService returns the OtelRPCService attribute
+func RPCService(v string) slog.Attr {
+	return slog.String(OtelRPCService, v)
+}
+
+// RPCSystem returns the OtelRPCSystem attribute
+func RPCSystem(v string) slog.Attr {
+	return slog.String(OtelRPCSystem, v)
+}
+
+// ServerAddress returns the OtelServerAddress and OtelServerPort attributes
+func ServerAddress(address string, port int) slog.Attr {
+	return slog.Group("", slog.String(OtelServerAddress, address), slog.Int(OtelServerPort, port))
+}
+
+// ServerPort returns the OtelServerPort attribute
+func ServerPort(v int) slog.Attr {
+	return slog.Int(OtelServerPort, v)
+}
+
+// ServiceInstanceID returns the OtelServiceInstanceID attribute
+func ServiceInstanceID(v string) slog.Attr {
+	return slog.String(OtelServiceInstanceID, v)
+}
+
+// ServiceName returns the OtelServiceName attribute
+func ServiceName(v string) slog.Attr {
+	return slog.String(OtelServiceName, v)
+}
+
+// ServiceNamespace returns the OtelServiceNamespace attribute
+func ServiceNamespace(v string) slog.Attr {
+	return slog.String(OtelServiceNamespace, v)
+}
+
+// ServiceVersion returns the OtelServiceVersion attribute
+func ServiceVersion(v string) slog.Attr {
+	return slog.String(OtelServiceVersion, v)
+}
+
+// SessionID returns the OtelSessionID attribute
+func SessionID(v string) slog.Attr {
+	return slog.String(OtelSessionID, v)
+}
+
+// SessionPreviousID returns the OtelSessionPreviousID attribute
+func SessionPreviousID(v string) slog.Attr {
+	return slog.String(OtelSessionPreviousID, v)
+}
+
+// TLSCipher returns the OtelTLSCipher attribute
+func TLSCipher(v string) slog.Attr {
+	return slog.String(OtelTLSCipher, v)
+}
+
+// TLSClientCertificate returns the OtelTLSClientCertificate attribute
+func TLSClientCertificate(v string) slog.Attr {
+	return slog.String(OtelTLSClientCertificate, v)
+}
+
+// TLSClientCertificateChain returns the OtelTLSClientCertificateChain attribute
+func TLSClientCertificateChain(v ...string) slog.Attr {
+	return slog.Any(OtelTLSClientCertificateChain, v)
+}
+
+// TLSClientHashMd5 returns the OtelTLSClientHashMd5 attribute
+func TLSClientHashMd5(v string) slog.Attr {
+	return slog.String(OtelTLSClientHashMd5, v)
+}
+
+// TLSClientHashSha1 returns the OtelTLSClientHashSha1 attribute
+func TLSClientHashSha1(v string) slog.Attr {
+	return slog.String(OtelTLSClientHashSha1, v)
+}
+
+// TLSClientHashSha256 returns the OtelTLSClientHashSha256 attribute
+func TLSClientHashSha256(v string) slog.Attr {
+	return slog.String(OtelTLSClientHashSha256, v)
+}
+
+// TLSClientIssuer returns the OtelTLSClientIssuer attribute
+func TLSClientIssuer(v string) slog.Attr {
+	return slog.String(OtelTLSClientIssuer, v)
+}
+
+// TLSClientJa3 returns the OtelTLSClientJa3 attribute
+func TLSClientJa3(v string) slog.Attr {
+	return slog.String(OtelTLSClientJa3, v)
+}
+
+// TLSClientNotAfter returns the OtelTLSClientNotAfter attribute
+func TLSClientNotAfter(v string) slog.Attr {
+	return slog.String(OtelTLSClientNotAfter, v)
+}
+
+// TLSClientNotBefore returns the OtelTLSClientNotBefore attribute
+func TLSClientNotBefore(v string) slog.Attr {
+	return slog.String(OtelTLSClientNotBefore, v)
+}
+
+// TLSClientSubject returns the OtelTLSClientSubject attribute
+func TLSClientSubject(v string) slog.Attr {
+	return slog.String(OtelTLSClientSubject, v)
+}
+
+// TLSClientSupportedCiphers returns the OtelTLSClientSupportedCiphers attribute
+func TLSClientSupportedCiphers(v ...string) slog.Attr {
+	return slog.Any(OtelTLSClientSupportedCiphers, v)
+}
+
+// TLSCurve returns the OtelTLSCurve attribute
+func TLSCurve(v string) slog.Attr {
+	return slog.String(OtelTLSCurve, v)
+}
+
+// TLSEstablished returns the OtelTLSEstablished attribute
+func TLSEstablished(v bool) slog.Attr {
+	return slog.Bool(OtelTLSEstablished, v)
+}
+
+// TLSNextProtocol returns the OtelTLSNextProtocol attribute
+func TLSNextProtocol(v string) slog.Attr {
+	return slog.String(OtelTLSNextProtocol, v)
+}
+
+// TLSProtocolName returns the OtelTLSProtocolName attribute
+func TLSProtocolName(v string) slog.Attr {
+	return slog.String(OtelTLSProtocolName, v)
+}
+
+// TLSProtocolVersion returns the OtelTLSProtocolVersion attribute
+func TLSProtocolVersion(v string) slog.Attr {
+	return slog.String(OtelTLSProtocolVersion, v)
+}
+
+// TLSResumed returns the OtelTLSResumed attribute
+func TLSResumed(v bool) slog.Attr {
+	return slog.Bool(OtelTLSResumed, v)
+}
+
+// TLSServerCertificate returns the OtelTLSServerCertificate attribute
+func TLSServerCertificate(v string) slog.Attr {
+	return slog.String(OtelTLSServerCertificate, v)
+}
+
+// TLSServerCertificateChain returns the OtelTLSServerCertificateChain attribute
+func TLSServerCertificateChain(v ...string) slog.Attr {
+	return slog.Any(OtelTLSServerCertificateChain, v)
+}
+
+// TLSServerHashMd5 returns the OtelTLSServerHashMd5 attribute
+func TLSServerHashMd5(v string) slog.Attr {
+	return slog.String(OtelTLSServerHashMd5, v)
+}
+
+// TLSServerHashSha1 returns the OtelTLSServerHashSha1 attribute
+func TLSServerHashSha1(v string) slog.Attr {
+	return slog.String(OtelTLSServerHashSha1, v)
+}
+
+// TLSServerHashSha256 returns the OtelTLSServerHashSha256 attribute
+func TLSServerHashSha256(v string) slog.Attr {
+	return slog.String(OtelTLSServerHashSha256, v)
+}
+
+// TLSServerIssuer returns the OtelTLSServerIssuer attribute
+func TLSServerIssuer(v string) slog.Attr {
+	return slog.String(OtelTLSServerIssuer, v)
+}
+
+// TLSServerJa3s returns the OtelTLSServerJa3s attribute
+func TLSServerJa3s(v string) slog.Attr {
+	return slog.String(OtelTLSServerJa3s, v)
+}
+
+// TLSServerNotAfter returns the OtelTLSServerNotAfter attribute
+func TLSServerNotAfter(v string) slog.Attr {
+	return slog.String(OtelTLSServerNotAfter, v)
+}
+
+// TLSServerNotBefore returns the OtelTLSServerNotBefore attribute
+func TLSServerNotBefore(v string) slog.Attr {
+	return slog.String(OtelTLSServerNotBefore, v)
+}
+
+// TLSServerSubject returns the OtelTLSServerSubject attribute
+func TLSServerSubject(v string) slog.Attr {
+	return slog.String(OtelTLSServerSubject, v)
+}
+
+// URLDomain returns the OtelURLDomain attribute
+func URLDomain(v string) slog.Attr {
+	return slog.String(OtelURLDomain, v)
+}
+
+// URLExtension returns the OtelURLExtension attribute
+func URLExtension(v string) slog.Attr {
+	return slog.String(OtelURLExtension, v)
+}
+
+// URLFragment returns the OtelURLFragment attribute
+func URLFragment(v string) slog.Attr {
+	return slog.String(OtelURLFragment, v)
+}
+
+// URLFull returns the OtelURLFull attribute
+func URLFull(v string) slog.Attr {
+	return slog.String(OtelURLFull, v)
+}
+
+// URLOriginal returns the OtelURLOriginal attribute
+func URLOriginal(v string) slog.Attr {
+	return slog.String(OtelURLOriginal, v)
+}
+
+// URLPath returns the OtelURLPath attribute
+func URLPath(v string) slog.Attr {
+	return slog.String(OtelURLPath, v)
+}
+
+// URLPort returns the OtelURLPort attribute
+func URLPort(v int) slog.Attr {
+	return slog.Int(OtelURLPort, v)
+}
+
+// URLQuery returns the OtelURLQuery attribute
+func URLQuery(v string) slog.Attr {
+	return slog.String(OtelURLQuery, v)
+}
+
+// URLRegisteredDomain returns the OtelURLRegisteredDomain attribute
+func URLRegisteredDomain(v string) slog.Attr {
+	return slog.String(OtelURLRegisteredDomain, v)
+}
+
+// URLScheme returns the OtelURLScheme attribute
+func URLScheme(v string) slog.Attr {
+	return slog.String(OtelURLScheme, v)
+}
+
+// URLSubdomain returns the OtelURLSubdomain attribute
+func URLSubdomain(v string) slog.Attr {
+	return slog.String(OtelURLSubdomain, v)
+}
+
+// URLTemplate returns the OtelURLTemplate attribute
+func URLTemplate(v string) slog.Attr {
+	return slog.String(OtelURLTemplate, v)
+}
+
+// URLTopLevelDomain returns the OtelURLTopLevelDomain attribute
+func URLTopLevelDomain(v string) slog.Attr {
+	return slog.String(OtelURLTopLevelDomain, v)
+}
+
+// UserEmail returns the OtelUserEmail attribute
+func UserEmail(v string) slog.Attr {
+	return slog.String(OtelUserEmail, v)
+}
+
+// UserFullName returns the OtelUserFullName attribute
+func UserFullName(v string) slog.Attr {
+	return slog.String(OtelUserFullName, v)
+}
+
+// UserHash returns the OtelUserHash attribute
+func UserHash(v string) slog.Attr {
+	return slog.String(OtelUserHash, v)
+}
+
+// UserID returns the OtelUserID attribute
+func UserID(v string) slog.Attr {
+	return slog.String(OtelUserID, v)
+}
+
+// UserName returns the OtelUserName attribute
+func UserName(v string) slog.Attr {
+	return slog.String(OtelUserName, v)
+}
+
+// UserRoles returns the OtelUserRoles attribute
+func UserRoles(v ...string) slog.Attr {
+	return slog.Any(OtelUserRoles, v)
+}
+
+// HTTPUserAgentName returns the OtelHTTPUserAgentName attribute
+func HTTPUserAgentName(v string) slog.Attr {
+	return slog.String(OtelHTTPUserAgentName, v)
+}
+
+// UserAgentOriginal returns the OtelUserAgentOriginal attribute
+func UserAgentOriginal(v string) slog.Attr {
+	return slog.String(OtelUserAgentOriginal, v)
+}
+
+// UserAgentVersion returns the OtelUserAgentVersion attribute
+func UserAgentVersion(v string) slog.Attr {
+	return slog.String(OtelUserAgentVersion, v)
+}
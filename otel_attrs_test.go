@@ -0,0 +1,30 @@
+package errors_test
+
+import (
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedAttrs(t *testing.T) {
+	assert.Equal(t, slog.KindInt64, errors.HTTPResponseStatusCode(500).Value.Kind())
+	assert.Equal(t, errors.OtelHTTPResponseStatusCode, errors.HTTPResponseStatusCode(500).Key)
+	assert.Equal(t, []string{"admin", "reader"}, errors.UserRoles("admin", "reader").Value.Any())
+	assert.Equal(t, slog.String(errors.OtelUserID, "thrawn"), errors.UserID("thrawn"))
+	assert.Equal(t, slog.String(errors.OtelDBSystem, "postgresql"), errors.DBSystem("postgresql"))
+	assert.Equal(t, slog.Int(errors.OtelRPCGRPCStatusCode, 14), errors.RPCGRPCStatusCode(14))
+	assert.Equal(t, slog.Int(errors.OtelMessagingBatchMessageCount, 10), errors.MessagingBatchMessageCount(10))
+
+	err := errors.With(errors.ServerAddress("example.com", 443), errors.UserID("thrawn")).
+		Error("error")
+	attrs := errors.AttrsFrom(err)
+	assert.Equal(t, []slog.Attr{
+		slog.String(errors.OtelServerAddress, "example.com"),
+		slog.Int(errors.OtelServerPort, 443),
+		slog.String(errors.OtelUserID, "thrawn"),
+	}, attrs)
+	assert.Equal(t, "error (server.address=example.com, server.port=443, user.id=thrawn)", fmt.Sprintf("%+v", err))
+}
@@ -0,0 +1,65 @@
+// Package otelspan adapts an OpenTelemetry `trace.Span` to the `errors.Span`
+// interface such that errors can be recorded with `errors.RecordOn()`.
+//
+//	errors.RecordOn(otelspan.New(span), err)
+package otelspan
+
+import (
+	"fmt"
+
+	"github.com/kapetan-io/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type span struct {
+	span trace.Span
+}
+
+// New returns an errors.Span which records to the provided OpenTelemetry span
+func New(s trace.Span) errors.Span {
+	return span{span: s}
+}
+
+func (s span) AddEvent(name string, attrs ...errors.SpanAttr) {
+	s.span.AddEvent(name, trace.WithAttributes(toKeyValues(attrs)...))
+}
+
+func (s span) SetAttributes(attrs ...errors.SpanAttr) {
+	s.span.SetAttributes(toKeyValues(attrs)...)
+}
+
+func (s span) SetStatus(code errors.SpanStatusCode, description string) {
+	s.span.SetStatus(codes.Code(code), description)
+}
+
+func toKeyValues(attrs []errors.SpanAttr) []attribute.KeyValue {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		kvs = append(kvs, toKeyValue(a))
+	}
+	return kvs
+}
+
+func toKeyValue(a errors.SpanAttr) attribute.KeyValue {
+	switch v := a.Value.(type) {
+	case string:
+		return attribute.String(a.Key, v)
+	case bool:
+		return attribute.Bool(a.Key, v)
+	case int64:
+		return attribute.Int64(a.Key, v)
+	case float64:
+		return attribute.Float64(a.Key, v)
+	case []string:
+		return attribute.StringSlice(a.Key, v)
+	case []bool:
+		return attribute.BoolSlice(a.Key, v)
+	case []int64:
+		return attribute.Int64Slice(a.Key, v)
+	case []float64:
+		return attribute.Float64Slice(a.Key, v)
+	}
+	return attribute.String(a.Key, fmt.Sprint(a.Value))
+}
@@ -0,0 +1,42 @@
+package otelspan_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/kapetan-io/errors/otelspan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRecordOn(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+
+	_, span := tp.Tracer("test").Start(context.Background(), "op")
+	err := errors.With("user.id", 1, "ids", []string{"a", "b"}).
+		WithKind(errors.NotFound).Errorf("while reading: %w", io.EOF)
+	errors.RecordOn(otelspan.New(span), err)
+	span.End()
+
+	spans := rec.Ended()
+	require.Len(t, spans, 1)
+	s := spans[0]
+	assert.Equal(t, codes.Error, s.Status().Code)
+	assert.Equal(t, "while reading: EOF", s.Status().Description)
+	assert.Contains(t, s.Attributes(), attribute.String(errors.OtelErrorType, "not_found"))
+
+	require.Len(t, s.Events(), 1)
+	e := s.Events()[0]
+	assert.Equal(t, "exception", e.Name)
+	assert.Contains(t, e.Attributes, attribute.String(errors.OtelExceptionType, "*errors.errorString"))
+	assert.Contains(t, e.Attributes, attribute.String(errors.OtelExceptionMessage, "while reading: EOF"))
+	assert.Contains(t, e.Attributes, attribute.Int64("user.id", 1))
+	assert.Contains(t, e.Attributes, attribute.StringSlice("ids", []string{"a", "b"}))
+}
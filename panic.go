@@ -0,0 +1,92 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+const panicValueKey = "panic.value"
+
+// Recover converts a recovered panic into an error which includes the panic value,
+// the type of the panic value and the full call stack at the code location of the
+// panic. The error includes the OTEL standard 'exception.type', 'exception.message'
+// and 'exception.stacktrace' attributes. Recover must be called directly by defer.
+//
+//	func doThing() (err error) {
+//		defer errors.Recover(&err)
+//		...
+//	}
+//
+// If the panic value is an error, it is wrapped by the returned error.
+func Recover(err *error) {
+	if r := recover(); r != nil {
+		*err = newPanicError(r)
+	}
+}
+
+// Go calls fn in a new goroutine and returns a channel which receives the error
+// returned by fn, or an error which describes the panic if fn panics. See Recover()
+//
+//	done := errors.Go(func() error {
+//		return doThing()
+//	})
+//	if err := <-done; err != nil {
+//		slog.Error("doThing failed", errors.AttrsFromAll(err)...)
+//	}
+func Go(fn func() error) <-chan error {
+	ch := make(chan error, 1)
+	go func() {
+		var err error
+		defer func() { ch <- err }()
+		defer Recover(&err)
+		err = fn()
+	}()
+	return ch
+}
+
+// newPanicError must be called directly by Recover()
+func newPanicError(r any) error {
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(3, pcs) // skip [runtime.Callers, newPanicError, Recover]
+	pcs = panicStack(pcs[:n])
+
+	var wrapped error
+	if err, ok := r.(error); ok {
+		wrapped = fmt.Errorf("panic: %w", err)
+	} else {
+		wrapped = errors.New(fmt.Sprintf("panic: %v", r))
+	}
+
+	var pc uintptr
+	if len(pcs) != 0 {
+		pc = pcs[0]
+	}
+	return &ErrAttrs{
+		wrapped: wrapped,
+		pc:      pc,
+		stack:   pcs,
+		attrs: With(
+			panicValueKey, r,
+			OtelExceptionType, fmt.Sprintf("%T", r),
+			OtelExceptionMessage, fmt.Sprint(r),
+		),
+	}
+}
+
+// panicStack trims the frames of the deferred function and go runtime from
+// the stack, such that the first frame is the code location of the panic.
+func panicStack(pcs []uintptr) []uintptr {
+	for i, pc := range pcs {
+		if frameFromPC(pc).Function != "runtime.gopanic" {
+			continue
+		}
+		pcs = pcs[i+1:]
+		for len(pcs) > 1 && strings.HasPrefix(frameFromPC(pcs[0]).Function, "runtime.") {
+			pcs = pcs[1:]
+		}
+		return pcs
+	}
+	return pcs
+}
@@ -0,0 +1,84 @@
+package errors_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func panicWith(v any) (err error) {
+	defer errors.Recover(&err)
+	panic(v)
+}
+
+func TestRecover(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		err := panicWith("boom")
+		require.Error(t, err)
+		assert.EqualError(t, err, "panic: boom")
+
+		as := errors.AttrsFromAll(err)
+		assert.Equal(t, "boom", findAttr(as, "panic.value").Value.Any())
+		assert.Equal(t, "string", findAttr(as, errors.OtelExceptionType).Value.String())
+		assert.Equal(t, "boom", findAttr(as, errors.OtelExceptionMessage).Value.String())
+		assert.Equal(t, "github.com/kapetan-io/errors_test.panicWith",
+			findAttr(as, errors.OtelCodeFunction).Value.String())
+
+		st := findAttr(as, errors.OtelExceptionStacktrace)
+		require.NotNil(t, st)
+		assert.True(t, strings.HasPrefix(st.Value.String(), "github.com/kapetan-io/errors_test.panicWith\n"), st.Value.String())
+		assert.Contains(t, st.Value.String(), "github.com/kapetan-io/errors_test.TestRecover")
+		assert.NotContains(t, st.Value.String(), "runtime.gopanic")
+
+		out := fmt.Sprintf("%+v", err)
+		assert.True(t, strings.HasPrefix(out, "panic: boom (panic.value=boom, exception.type=string, exception.message=boom)\n"+
+			"github.com/kapetan-io/errors_test.panicWith\n"), out)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		err := panicWith(io.EOF)
+		assert.EqualError(t, err, "panic: EOF")
+		assert.True(t, errors.Is(err, io.EOF))
+		assert.Equal(t, "*errors.errorString", findAttr(errors.AttrsFrom(err), errors.OtelExceptionType).Value.String())
+	})
+
+	t.Run("RuntimeError", func(t *testing.T) {
+		err := func() (err error) {
+			defer errors.Recover(&err)
+			var m map[string]int
+			m["key"] = 1
+			return nil
+		}()
+		require.Error(t, err)
+		st := findAttr(errors.AttrsFromAll(err), errors.OtelExceptionStacktrace).Value.String()
+		assert.True(t, strings.HasPrefix(st, "github.com/kapetan-io/errors_test.TestRecover.func"), st)
+	})
+
+	t.Run("NoPanic", func(t *testing.T) {
+		err := func() (err error) {
+			defer errors.Recover(&err)
+			return errors.New("returned")
+		}()
+		assert.EqualError(t, err, "returned")
+	})
+}
+
+func TestGo(t *testing.T) {
+	err := <-errors.Go(func() error {
+		panic("boom")
+	})
+	assert.EqualError(t, err, "panic: boom")
+	assert.True(t, errors.Is(err, &errors.ErrAttrs{}))
+
+	err = <-errors.Go(func() error {
+		return errors.New("returned")
+	})
+	assert.EqualError(t, err, "returned")
+
+	assert.NoError(t, <-errors.Go(func() error { return nil }))
+}
@@ -0,0 +1,234 @@
+package errors
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"regexp"
+	"sync/atomic"
+)
+
+// RedactedValue replaces the value of attributes which are redacted
+const RedactedValue = "[REDACTED]"
+
+// RedactAction is the action taken on an attribute by a Redactor
+type RedactAction int
+
+const (
+	// RedactDrop removes the attribute
+	RedactDrop RedactAction = iota + 1
+	// RedactMask replaces the value with RedactedValue
+	RedactMask
+	// RedactHash replaces the value with the hex encoded HMAC-SHA256 of the value
+	// using Redactor.HashKey, such that equal values can be correlated without
+	// revealing the value. If Redactor.HashKey is empty the value is masked instead,
+	// as an unkeyed hash of a low entropy value such as an email is easily reversed.
+	RedactHash
+	// RedactTruncate keeps only the first RedactRule.MaxLen characters of the value
+	RedactTruncate
+)
+
+// RedactRule is the rule applied to an attribute with a matching key
+type RedactRule struct {
+	Action RedactAction
+	// MaxLen is the number of characters kept by RedactTruncate
+	MaxLen int
+}
+
+// Detector returns the value with any sensitive data replaced. Detectors are
+// applied to all string attribute values which do not match a RedactRule.
+type Detector func(value string) string
+
+// Redactor redacts sensitive attributes from errors before they are returned by
+// AttrsFrom*(), formatted with `%+v` or encoded with MarshalJSON(). Error messages
+// are redacted in the same way as the "error" attribute returned by AttrsFromWithErr()
+// when formatted with `%+v`, encoded with MarshalJSON() or returned by Layers().
+// Error() and `%v` always return the message as is. See SetRedactor()
+type Redactor struct {
+	// Rules maps an attribute key to the rule applied to attributes with that key,
+	// a rule on the key of a group is applied to the entire group
+	Rules map[string]RedactRule
+	// Detectors are applied to string values of attributes which have no rule
+	Detectors []Detector
+	// HashKey is the key used by RedactHash, values are masked if empty
+	HashKey []byte
+}
+
+var redactor atomic.Pointer[Redactor]
+
+// SetRedactor sets the Redactor applied to all attributes extracted from errors.
+// A nil Redactor disables redaction, attributes created with Secret() are always
+// redacted regardless of the Redactor.
+//
+//	errors.SetRedactor(&errors.Redactor{
+//		Rules: map[string]errors.RedactRule{
+//			errors.OtelUserEmail:     {Action: errors.RedactHash},
+//			errors.OtelUserName:      {Action: errors.RedactMask},
+//			errors.OtelClientAddress: {Action: errors.RedactDrop},
+//		},
+//		Detectors: []errors.Detector{errors.DetectEmail, errors.DetectBearerToken},
+//		HashKey:   []byte(os.Getenv("REDACT_HASH_KEY")),
+//	})
+func SetRedactor(r *Redactor) {
+	redactor.Store(r)
+}
+
+// Secret returns an attribute whose value is always redacted when logged,
+// formatted or encoded.
+//
+//	errors.With(errors.Secret("password", pw)).Error("login failed")
+func Secret(key string, value any) slog.Attr {
+	return slog.Any(key, secret{value: value})
+}
+
+type secret struct {
+	value any
+}
+
+func (s secret) LogValue() slog.Value {
+	return slog.StringValue(RedactedValue)
+}
+
+func (s secret) String() string {
+	return RedactedValue
+}
+
+func (s secret) GoString() string {
+	return RedactedValue
+}
+
+// Redact returns a copy of attrs with the rules and detectors of the Redactor applied
+func (r *Redactor) Redact(attrs []slog.Attr) []slog.Attr {
+	result := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		if a, ok := r.redact(a); ok {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// RedactMessage returns msg with the rules and detectors of the Redactor applied
+// in the same way as the "error" attribute returned by AttrsFromWithErr(). If the
+// rule for "error" drops the attribute, RedactedValue is returned.
+func (r *Redactor) RedactMessage(msg string) string {
+	a, ok := r.redact(slog.String("error", msg))
+	if !ok {
+		return RedactedValue
+	}
+	return a.Value.String()
+}
+
+func (r *Redactor) redact(a slog.Attr) (slog.Attr, bool) {
+	a.Value = a.Value.Resolve()
+	if rule, ok := r.Rules[a.Key]; ok {
+		switch rule.Action {
+		case RedactDrop:
+			return slog.Attr{}, false
+		case RedactMask:
+			return slog.String(a.Key, RedactedValue), true
+		case RedactHash:
+			if len(r.HashKey) == 0 {
+				return slog.String(a.Key, RedactedValue), true
+			}
+			h := hmac.New(sha256.New, r.HashKey)
+			h.Write([]byte(a.Value.String()))
+			return slog.String(a.Key, hex.EncodeToString(h.Sum(nil))), true
+		case RedactTruncate:
+			return slog.String(a.Key, truncate(a.Value.String(), rule.MaxLen)), true
+		}
+		return a, true
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(r.Redact(a.Value.Group())...)}, true
+	}
+
+	if a.Value.Kind() == slog.KindString && len(r.Detectors) != 0 {
+		v := a.Value.String()
+		for _, d := range r.Detectors {
+			v = d(v)
+		}
+		return slog.String(a.Key, v), true
+	}
+	return a, true
+}
+
+var (
+	emailRegex  = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+	bearerRegex = regexp.MustCompile(`(?i)\bbearer\s+[a-zA-Z0-9\-._~+/]+=*`)
+	cardRegex   = regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`)
+)
+
+// DetectEmail replaces email addresses with RedactedValue
+func DetectEmail(value string) string {
+	return emailRegex.ReplaceAllString(value, RedactedValue)
+}
+
+// DetectBearerToken replaces HTTP bearer tokens such as `Bearer eyJhbGc...` with RedactedValue
+func DetectBearerToken(value string) string {
+	return bearerRegex.ReplaceAllString(value, "Bearer "+RedactedValue)
+}
+
+// DetectCreditCard replaces sequences of 13 to 19 digits, optionally separated by
+// spaces or dashes, which pass the Luhn checksum with RedactedValue
+func DetectCreditCard(value string) string {
+	return cardRegex.ReplaceAllStringFunc(value, func(s string) string {
+		if luhn(s) {
+			return RedactedValue
+		}
+		return s
+	})
+}
+
+// luhn reports if the digits in s pass the Luhn checksum
+func luhn(s string) bool {
+	var sum int
+	var double bool
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "..."
+}
+
+// redact applies the Redactor set by SetRedactor() to attrs
+func redact(attrs []slog.Attr) []slog.Attr {
+	r := redactor.Load()
+	if r == nil {
+		return attrs
+	}
+	return r.Redact(attrs)
+}
+
+// RedactMessage applies the Redactor set by SetRedactor() to an error message.
+// It is used by packages which render error messages for external systems.
+//
+//	problem.Detail = errors.RedactMessage(err.Error())
+func RedactMessage(msg string) string {
+	r := redactor.Load()
+	if r == nil {
+		return msg
+	}
+	return r.RedactMessage(msg)
+}
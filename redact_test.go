@@ -0,0 +1,142 @@
+package errors_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecret(t *testing.T) {
+	err := errors.With(errors.Secret("password", "hunter2"), "user", "admin").Error("login failed")
+
+	assert.Equal(t, "login failed (password=[REDACTED], user=admin)", fmt.Sprintf("%+v", err))
+
+	var w bytes.Buffer
+	log := slog.New(slog.NewTextHandler(&w, nil))
+	log.LogAttrs(context.Background(), slog.LevelError, "failed", errors.AttrsFrom(err)...)
+	assert.Contains(t, w.String(), "password=[REDACTED] user=admin")
+	assert.NotContains(t, w.String(), "hunter2")
+
+	b, err2 := errors.MarshalJSON(err)
+	require.NoError(t, err2)
+	assert.NotContains(t, string(b), "hunter2")
+}
+
+func TestRedactor(t *testing.T) {
+	key := []byte("secret-key")
+	errors.SetRedactor(&errors.Redactor{
+		Rules: map[string]errors.RedactRule{
+			errors.OtelUserEmail:     {Action: errors.RedactHash},
+			errors.OtelUserName:      {Action: errors.RedactMask},
+			errors.OtelClientAddress: {Action: errors.RedactDrop},
+			"note":                   {Action: errors.RedactTruncate, MaxLen: 5},
+		},
+		Detectors: []errors.Detector{errors.DetectEmail, errors.DetectBearerToken, errors.DetectCreditCard},
+		HashKey:   key,
+	})
+	defer errors.SetRedactor(nil)
+
+	err := errors.With(
+		errors.OtelUserEmail, "user@example.com",
+		errors.OtelUserName, "Thrawn",
+		errors.OtelClientAddress, "10.0.0.1",
+		"note", "a very long note",
+		"header", "Authorization: Bearer eyJhbGciOi.abc-123",
+		"contact", "contact admin@example.com for help",
+		"card", "4111 1111 1111 1111",
+		"order", "1234567890123",
+		slog.Group("request", errors.OtelUserName, "nested"),
+	).Error("failed")
+
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte("user@example.com"))
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	expected := fmt.Sprintf("[user.email=%s user.name=[REDACTED] note=a ver... "+
+		"header=Authorization: Bearer [REDACTED] contact=contact [REDACTED] for help "+
+		"card=[REDACTED] order=1234567890123 request=[user.name=[REDACTED]]]", hash)
+
+	t.Run("AttrsFrom", func(t *testing.T) {
+		assert.Equal(t, expected, fmt.Sprintf("%v", errors.AttrsFrom(err)))
+		assert.Nil(t, findAttr(errors.AttrsFromAll(err), errors.OtelClientAddress))
+		assert.NotNil(t, findAttr(errors.AttrsFromAll(err), errors.OtelCodeFunction))
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		out := fmt.Sprintf("%+v", err)
+		assert.Contains(t, out, "user.name=[REDACTED]")
+		assert.NotContains(t, out, "10.0.0.1")
+		assert.NotContains(t, out, "Thrawn")
+	})
+
+	t.Run("MarshalJSON", func(t *testing.T) {
+		b, err2 := errors.MarshalJSON(err)
+		require.NoError(t, err2)
+		assert.NotContains(t, string(b), "10.0.0.1")
+		assert.NotContains(t, string(b), "user@example.com")
+		assert.Contains(t, string(b), hash)
+	})
+
+	t.Run("ErrorMessageAttr", func(t *testing.T) {
+		as := errors.AttrsFromWithErr(errors.Errorf("user 'user@example.com' not found"))
+		assert.Equal(t, "user '[REDACTED]' not found", findAttr(as, "error").Value.String())
+	})
+
+	t.Run("ErrorMessage", func(t *testing.T) {
+		err := errors.With("foo", "bar").Errorf("user 'user@example.com' not found: %w",
+			errors.New("contact admin@example.com"))
+		assert.Equal(t, "user 'user@example.com' not found: contact admin@example.com", err.Error())
+		assert.Equal(t, "user '[REDACTED]' not found: contact [REDACTED] (foo=bar)",
+			strings.SplitN(fmt.Sprintf("%+v", err), "\n", 2)[0])
+
+		b, err2 := errors.MarshalJSON(err)
+		require.NoError(t, err2)
+		assert.NotContains(t, string(b), "example.com")
+
+		layers := errors.Layers(err)
+		require.Len(t, layers, 1)
+		assert.Equal(t, "user '[REDACTED]' not found: contact [REDACTED]", layers[0].Msg)
+
+		join := errors.Join(errors.New("from admin@example.com"), err)
+		assert.NotContains(t, fmt.Sprintf("%+v", errors.With("a", "b").Join(join)), "example.com")
+	})
+}
+
+func TestRedactorGroupRule(t *testing.T) {
+	errors.SetRedactor(&errors.Redactor{
+		Rules: map[string]errors.RedactRule{
+			"user":    {Action: errors.RedactDrop},
+			"request": {Action: errors.RedactMask},
+		},
+	})
+	defer errors.SetRedactor(nil)
+
+	err := errors.With(
+		slog.Group("user", errors.OtelUserName, "Thrawn"),
+		slog.Group("request", "id", "req-1"),
+		"foo", "bar",
+	).Error("failed")
+	assert.Equal(t, "[request=[REDACTED] foo=bar]", fmt.Sprintf("%v", errors.AttrsFrom(err)))
+}
+
+func TestRedactHashWithoutKey(t *testing.T) {
+	errors.SetRedactor(&errors.Redactor{
+		Rules: map[string]errors.RedactRule{
+			errors.OtelUserEmail: {Action: errors.RedactHash},
+		},
+	})
+	defer errors.SetRedactor(nil)
+
+	err := errors.With(errors.OtelUserEmail, "user@example.com").Error("failed")
+	assert.Equal(t, "[user.email=[REDACTED]]", fmt.Sprintf("%v", errors.AttrsFrom(err)))
+}
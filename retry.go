@@ -0,0 +1,206 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"math/rand"
+	"net"
+	"syscall"
+	"time"
+)
+
+const retryAttemptKey = "retry.attempt"
+
+// WithRetryable returns an *Attrs which marks the error as retryable. See IsRetryable()
+func WithRetryable() *Attrs {
+	a := &Attrs{}
+	return a.WithRetryable()
+}
+
+// WithRetryAfter returns an *Attrs which marks the error as retryable after
+// the given duration. See RetryAfter()
+func WithRetryAfter(d time.Duration) *Attrs {
+	a := &Attrs{}
+	return a.WithRetryAfter(d)
+}
+
+// WithRetryable returns a new *Attrs which marks the error as retryable
+// combined with any existing attributes defined in the current Attrs.
+//
+//	return errors.With("host", host).WithRetryable().Errorf("while connecting: %w", err)
+func (a *Attrs) WithRetryable() *Attrs {
+	n := *a
+	n.retryable = true
+	return &n
+}
+
+// WithRetryAfter returns a new *Attrs which marks the error as retryable after the
+// given duration combined with any existing attributes defined in the current Attrs.
+//
+//	return errors.WithRetryAfter(time.Minute).Error("rate limit exceeded")
+func (a *Attrs) WithRetryAfter(d time.Duration) *Attrs {
+	n := *a
+	n.retryable = true
+	n.retryAfter = d
+	return &n
+}
+
+// IsRetryable reports whether the operation which returned err can be retried.
+// Returns true if any error in the err tree was marked as retryable via
+// Attrs.WithRetryable() or Attrs.WithRetryAfter(), or if the err tree contains
+// a common temporary error, such as:
+//
+//   - context.DeadlineExceeded
+//   - a net.Error which is a timeout
+//   - syscall.ECONNRESET, syscall.ECONNABORTED or syscall.ECONNREFUSED
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if _, ok := retryFromTree(err); ok {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
+
+// RetryAfter returns the duration to wait before retrying the operation which
+// returned err as attached by Attrs.WithRetryAfter(). Returns false if no
+// error in the err tree includes a retry after duration.
+func RetryAfter(err error) (time.Duration, bool) {
+	d, ok := retryFromTree(err)
+	return d, ok && d > 0
+}
+
+// retryFromTree returns the retry after duration of the first error in the err tree
+// which was marked as retryable with a duration, else the duration is zero. Returns
+// false if no error in the err tree was marked as retryable.
+func retryFromTree(err error) (time.Duration, bool) {
+	var found bool
+	for err != nil {
+		switch x := err.(type) {
+		case *ErrAttrs:
+			if x.attrs.retryAfter > 0 {
+				return x.attrs.retryAfter, true
+			}
+			found = found || x.attrs.retryable
+			err = x.wrapped
+		case interface{ Unwrap() []error }:
+			for _, branch := range x.Unwrap() {
+				d, ok := retryFromTree(branch)
+				if d > 0 {
+					return d, true
+				}
+				found = found || ok
+			}
+			return 0, found
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		default:
+			return 0, found
+		}
+	}
+	return 0, found
+}
+
+// RetryPolicy configures the exponential backoff used by Retry(). Fields
+// with a zero value are replaced by the value from DefaultRetryPolicy.
+type RetryPolicy struct {
+	// Attempts is the maximum number of times the operation is attempted
+	Attempts int
+	// Min is the delay before the first retry
+	Min time.Duration
+	// Max is the maximum delay between retries
+	Max time.Duration
+	// Factor is the multiplier applied to the delay after each retry
+	Factor float64
+	// Jitter is the fraction of the delay which is randomized to avoid
+	// retries from multiple clients occurring in lock step. A negative
+	// value disables jitter.
+	Jitter float64
+}
+
+// DefaultRetryPolicy is used by Retry() for any RetryPolicy fields with a zero value
+var DefaultRetryPolicy = RetryPolicy{
+	Attempts: 5,
+	Min:      100 * time.Millisecond,
+	Max:      10 * time.Second,
+	Factor:   2,
+	Jitter:   0.2,
+}
+
+// Retry calls fn until it returns nil, returns an error which is not retryable,
+// the maximum number of attempts is reached or ctx is done. Between each attempt
+// Retry waits using exponential backoff with jitter, unless the error includes
+// a retry after duration (see RetryAfter()) in which case that duration is used.
+//
+// The error returned includes the number of attempts made as the 'retry.attempt'
+// attribute.
+//
+//	err := errors.Retry(ctx, errors.RetryPolicy{Attempts: 3}, func(ctx context.Context) error {
+//		return client.Do(ctx, req)
+//	})
+func Retry(ctx context.Context, p RetryPolicy, fn func(context.Context) error) error {
+	p = p.withDefaults()
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+		if attempt >= p.Attempts || !IsRetryable(err) {
+			return WithAttr(slog.Int(retryAttemptKey, attempt)).Wrap(err)
+		}
+
+		delay, ok := RetryAfter(err)
+		if !ok {
+			delay = p.backoff(attempt)
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return WithAttr(slog.Int(retryAttemptKey, attempt)).Wrap(err)
+		case <-t.C:
+		}
+	}
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.Attempts == 0 {
+		p.Attempts = DefaultRetryPolicy.Attempts
+	}
+	if p.Min == 0 {
+		p.Min = DefaultRetryPolicy.Min
+	}
+	if p.Max == 0 {
+		p.Max = DefaultRetryPolicy.Max
+	}
+	if p.Factor == 0 {
+		p.Factor = DefaultRetryPolicy.Factor
+	}
+	if p.Jitter == 0 {
+		p.Jitter = DefaultRetryPolicy.Jitter
+	}
+	return p
+}
+
+// backoff returns the delay before the next attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.Min) * math.Pow(p.Factor, float64(attempt-1))
+	if d > float64(p.Max) {
+		d = float64(p.Max)
+	}
+	if p.Jitter > 0 {
+		d -= d * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+	return time.Duration(d)
+}
@@ -0,0 +1,122 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryable(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "Nil", err: nil, want: false},
+		{name: "Plain", err: errors.New("plain"), want: false},
+		{name: "Marked", err: errors.WithRetryable().Error("retry"), want: true},
+		{name: "MarkedWithAttrs", err: errors.With("k", "v").WithRetryable().Error("retry"), want: true},
+		{name: "Wrapped", err: fmt.Errorf("wrap: %w", errors.WithRetryAfter(time.Second).Error("retry")), want: true},
+		{name: "Joined", err: errors.Join(errors.New("one"), errors.WithRetryable().Error("two")), want: true},
+		{name: "DeadlineExceeded", err: errors.Errorf("wrap: %w", context.DeadlineExceeded), want: true},
+		{name: "Canceled", err: errors.Errorf("wrap: %w", context.Canceled), want: false},
+		{name: "ConnReset", err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, want: true},
+		{name: "NetTimeout", err: &net.DNSError{Err: "timeout", IsTimeout: true}, want: true},
+		{name: "NetNotTimeout", err: &net.DNSError{Err: "no such host", IsNotFound: true}, want: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errors.IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	d, ok := errors.RetryAfter(errors.Wrap(errors.With("k", "v").WithRetryAfter(time.Minute).Error("rate limited")))
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, d)
+
+	_, ok = errors.RetryAfter(errors.WithRetryable().Error("retry"))
+	assert.False(t, ok)
+
+	_, ok = errors.RetryAfter(errors.New("plain"))
+	assert.False(t, ok)
+
+	// Survives a JSON round trip
+	b, err := errors.MarshalJSON(errors.WithRetryAfter(time.Minute).Error("rate limited"))
+	require.NoError(t, err)
+	decoded, err := errors.UnmarshalJSON(b)
+	require.NoError(t, err)
+	assert.True(t, errors.IsRetryable(decoded))
+	d, ok = errors.RetryAfter(decoded)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, d)
+}
+
+func TestRetry(t *testing.T) {
+	policy := errors.RetryPolicy{Attempts: 3, Min: time.Millisecond, Max: 5 * time.Millisecond}
+
+	t.Run("Success", func(t *testing.T) {
+		var count int
+		err := errors.Retry(context.Background(), policy, func(ctx context.Context) error {
+			count++
+			if count < 2 {
+				return errors.WithRetryable().Error("retry")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("MaxAttempts", func(t *testing.T) {
+		var count int
+		err := errors.Retry(context.Background(), policy, func(ctx context.Context) error {
+			count++
+			return errors.With("count", count).WithRetryable().Error("retry")
+		})
+		require.Error(t, err)
+		assert.Equal(t, 3, count)
+		assert.Equal(t, "retry (count=3) (retry.attempt=3, count=3)", fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("NotRetryable", func(t *testing.T) {
+		var count int
+		err := errors.Retry(context.Background(), policy, func(ctx context.Context) error {
+			count++
+			return errors.New("fatal")
+		})
+		assert.Equal(t, 1, count)
+		assert.Equal(t, "fatal (retry.attempt=1)", fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("RetryAfter", func(t *testing.T) {
+		var count int
+		start := time.Now()
+		err := errors.Retry(context.Background(), policy, func(ctx context.Context) error {
+			count++
+			if count < 2 {
+				return errors.WithRetryAfter(50 * time.Millisecond).Error("rate limited")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		err := errors.Retry(ctx, errors.RetryPolicy{Min: time.Hour}, func(ctx context.Context) error {
+			cancel()
+			return errors.WithRetryable().Error("retry")
+		})
+		assert.Equal(t, "retry () (retry.attempt=1)", fmt.Sprintf("%+v", err))
+	})
+}
@@ -0,0 +1,302 @@
+// Package sentry converts errors into Sentry events, such that each layer of the err
+// tree is reported as a chained exception with the code location and stack captured
+// by the layer, and sends them to Sentry or any Sentry protocol compatible collector.
+//
+//	t, err := sentry.NewHTTPTransport(os.Getenv("SENTRY_DSN"))
+//	if err != nil {
+//		return err
+//	}
+//
+//	opts := &sentry.Options{Rules: map[string]sentry.Target{errors.OtelUserID: sentry.TargetTag}}
+//	if err := sentry.Send(ctx, t, err, opts); err != nil {
+//		slog.Error("while sending to sentry", "error", err)
+//	}
+package sentry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/kapetan-io/errors"
+)
+
+// Event is a Sentry event, see https://develop.sentry.dev/sdk/data-model/event-payloads/
+type Event struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Exception   *Exceptions       `json:"exception,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Extra       map[string]any    `json:"extra,omitempty"`
+	Fingerprint []string          `json:"fingerprint,omitempty"`
+}
+
+// Exceptions holds the chained exceptions of an Event sorted oldest to newest,
+// such that the root cause of the error is first.
+type Exceptions struct {
+	Values []Exception `json:"values"`
+}
+
+// Exception is a single layer of the err tree
+type Exception struct {
+	Type       string      `json:"type"`
+	Value      string      `json:"value"`
+	Module     string      `json:"module,omitempty"`
+	Stacktrace *Stacktrace `json:"stacktrace,omitempty"`
+}
+
+// Stacktrace holds the frames of an Exception sorted oldest to newest,
+// such that the frame where the layer was created is last.
+type Stacktrace struct {
+	Frames []Frame `json:"frames"`
+}
+
+// Frame is a single frame of a Stacktrace
+type Frame struct {
+	Function string `json:"function,omitempty"`
+	Module   string `json:"module,omitempty"`
+	Filename string `json:"filename,omitempty"`
+	AbsPath  string `json:"abs_path,omitempty"`
+	Lineno   int    `json:"lineno,omitempty"`
+	InApp    bool   `json:"in_app"`
+}
+
+// Target is where an attribute is placed in the Event
+type Target int
+
+const (
+	// TargetExtra places the attribute in Event.Extra
+	TargetExtra Target = iota
+	// TargetTag places the attribute in Event.Tags, which are indexed and searchable in Sentry
+	TargetTag
+	// TargetDrop does not include the attribute in the Event
+	TargetDrop
+)
+
+// Options control how errors are converted into an Event
+type Options struct {
+	// Rules is the Target of attributes by key, attributes within a group are
+	// matched by the key of the group joined with the key of the attribute by '.'
+	Rules map[string]Target
+	// Default is the Target of attributes which have no rule, TargetExtra if not set
+	Default     Target
+	Environment string
+	Release     string
+	ServerName  string
+}
+
+// NewEvent returns a Sentry Event for the err tree. Each layer of the err tree created
+// by this package is an entry in Event.Exception with the stack captured by the layer,
+// or the code location where the layer was created if no stack was captured. The
+// attributes of the err tree are placed in Event.Tags or Event.Extra according to
+// Options.Rules, and the fingerprint is errors.Fingerprint(err). If err is nil,
+// NewEvent returns the zero Event.
+func NewEvent(err error, opts *Options) Event {
+	if err == nil {
+		return Event{}
+	}
+	if opts == nil {
+		opts = &Options{}
+	}
+	e := Event{
+		EventID:     eventID(),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339Nano),
+		Level:       "error",
+		Platform:    "go",
+		Environment: opts.Environment,
+		Release:     opts.Release,
+		ServerName:  opts.ServerName,
+		Fingerprint: []string{errors.Fingerprint(err)},
+	}
+
+	var values []Exception
+	if layers := errors.Layers(err); layers != nil {
+		values = exceptions(layers)
+	} else {
+		values = []Exception{{Type: fmt.Sprintf("%T", err), Value: errors.RedactMessage(err.Error())}}
+	}
+	e.Exception = &Exceptions{Values: values}
+
+	for _, a := range errors.AttrsFrom(err) {
+		addAttr(&e, opts, "", a)
+	}
+	return e
+}
+
+// exceptions returns the exceptions for the layers sorted oldest to newest
+func exceptions(layers []errors.Layer) []Exception {
+	var result []Exception
+	for i := len(layers) - 1; i >= 0; i-- {
+		l := layers[i]
+		if l.Branches != nil {
+			for _, b := range l.Branches {
+				result = append(result, exceptions(b)...)
+			}
+			continue
+		}
+		msg := l.Msg
+		// Include the root cause when it is not just the message of the layer, such
+		// as the error wrapped by `errors.Errorf("while reading: %w", io.EOF)`
+		if i == len(layers)-1 {
+			if cause := rootCause(l.Err); cause != nil {
+				if value := errors.RedactMessage(cause.Error()); value != msg {
+					result = append(result, Exception{Type: fmt.Sprintf("%T", cause), Value: value})
+					msg = strings.TrimRight(strings.TrimSuffix(msg, value), ": ")
+				}
+			}
+		}
+		result = append(result, Exception{
+			Type:       exceptionType(l),
+			Value:      msg,
+			Module:     module(l.Frame.Function),
+			Stacktrace: stacktrace(l),
+		})
+	}
+	return result
+}
+
+// rootCause returns the error at the root of the err tree below err, or nil if there is none
+func rootCause(err error) error {
+	var cause error
+	for u := errors.Unwrap(err); u != nil; u = errors.Unwrap(u) {
+		cause = u
+	}
+	return cause
+}
+
+// exceptionType returns the 'exception.type' attribute of the layer if any, such as
+// those created by errors.Recover(), else the Kind of the layer or the type of the error.
+func exceptionType(l errors.Layer) string {
+	var kind string
+	for _, a := range l.Attrs {
+		switch a.Key {
+		case errors.OtelExceptionType:
+			return a.Value.String()
+		case errors.OtelErrorType:
+			kind = a.Value.String()
+		}
+	}
+	if kind != "" {
+		return kind
+	}
+	return fmt.Sprintf("%T", l.Err)
+}
+
+func stacktrace(l errors.Layer) *Stacktrace {
+	frames := l.Stack
+	if len(frames) == 0 && l.Frame.Function != "" {
+		frames = []runtime.Frame{l.Frame}
+	}
+	if len(frames) == 0 {
+		return nil
+	}
+	st := &Stacktrace{Frames: make([]Frame, 0, len(frames))}
+	for i := len(frames) - 1; i >= 0; i-- {
+		st.Frames = append(st.Frames, newFrame(frames[i]))
+	}
+	return st
+}
+
+func newFrame(f runtime.Frame) Frame {
+	fn := f.Function
+	mod := module(fn)
+	if mod != "" {
+		fn = strings.TrimPrefix(fn, mod+".")
+	}
+	return Frame{
+		Function: fn,
+		Module:   mod,
+		Filename: filepath.Base(f.File),
+		AbsPath:  f.File,
+		Lineno:   f.Line,
+		// Standard library packages have no '.' in the first element of the import path
+		InApp: strings.Contains(strings.SplitN(mod, "/", 2)[0], "."),
+	}
+}
+
+// module returns the package import path of the fully qualified function name,
+// 'github.com/kapetan-io/errors.(*ErrAttrs).Error' becomes 'github.com/kapetan-io/errors'
+func module(fn string) string {
+	slash := strings.LastIndex(fn, "/")
+	dot := strings.Index(fn[slash+1:], ".")
+	if dot == -1 {
+		return ""
+	}
+	return fn[:slash+1+dot]
+}
+
+func addAttr(e *Event, opts *Options, prefix string, a slog.Attr) {
+	if a.Key == "" {
+		return
+	}
+	key := a.Key
+	if prefix != "" {
+		key = prefix + "." + a.Key
+	}
+	v := a.Value.Resolve()
+	if v.Kind() == slog.KindGroup {
+		for _, ga := range v.Group() {
+			addAttr(e, opts, key, ga)
+		}
+		return
+	}
+
+	target, ok := opts.Rules[key]
+	if !ok {
+		target = opts.Default
+	}
+	switch target {
+	case TargetTag:
+		if e.Tags == nil {
+			e.Tags = make(map[string]string)
+		}
+		e.Tags[key] = v.String()
+	case TargetExtra:
+		if e.Extra == nil {
+			e.Extra = make(map[string]any)
+		}
+		e.Extra[key] = extraValue(v)
+	}
+}
+
+func extraValue(v slog.Value) any {
+	switch v.Kind() {
+	case slog.KindDuration, slog.KindTime:
+		return v.String()
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			return err.Error()
+		}
+	}
+	return v.Any()
+}
+
+// eventID returns a random UUID v4 as 32 hex characters without dashes
+func eventID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return hex.EncodeToString(b[:])
+}
+
+// Send converts the err tree into an Event using NewEvent() and sends it with the Transport.
+// If err is nil, nothing is sent and Send returns nil.
+func Send(ctx context.Context, t Transport, err error, opts *Options) error {
+	if err == nil {
+		return nil
+	}
+	e := NewEvent(err, opts)
+	return t.Send(ctx, &e)
+}
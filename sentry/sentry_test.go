@@ -0,0 +1,111 @@
+package sentry_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/kapetan-io/errors"
+	"github.com/kapetan-io/errors/sentry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readUser(id string) error {
+	err := errors.With(errors.OtelUserID, id, "attempt", 2).
+		WithKind(errors.NotFound).Errorf("while reading: %w", io.EOF)
+	return errors.With("table", "users", "timeout", time.Second).Errorf("query failed: %w", err)
+}
+
+func TestNewEvent(t *testing.T) {
+	opts := &sentry.Options{
+		Rules: map[string]sentry.Target{
+			errors.OtelUserID:    sentry.TargetTag,
+			errors.OtelErrorType: sentry.TargetTag,
+			"attempt":            sentry.TargetDrop,
+		},
+		Environment: "test",
+	}
+
+	t.Run("Layers", func(t *testing.T) {
+		err := readUser("user-1")
+		e := sentry.NewEvent(err, opts)
+
+		assert.Len(t, e.EventID, 32)
+		assert.Equal(t, "error", e.Level)
+		assert.Equal(t, "go", e.Platform)
+		assert.Equal(t, "test", e.Environment)
+		assert.Equal(t, []string{errors.Fingerprint(err)}, e.Fingerprint)
+
+		require.NotNil(t, e.Exception)
+		values := e.Exception.Values
+		require.Len(t, values, 3)
+		assert.Equal(t, "*errors.errorString", values[0].Type)
+		assert.Equal(t, "EOF", values[0].Value)
+		assert.Nil(t, values[0].Stacktrace)
+
+		assert.Equal(t, "not_found", values[1].Type)
+		assert.Equal(t, "while reading", values[1].Value)
+		assert.Equal(t, "github.com/kapetan-io/errors/sentry_test", values[1].Module)
+		require.NotNil(t, values[1].Stacktrace)
+		require.Len(t, values[1].Stacktrace.Frames, 1)
+		f := values[1].Stacktrace.Frames[0]
+		assert.Equal(t, "readUser", f.Function)
+		assert.Equal(t, "github.com/kapetan-io/errors/sentry_test", f.Module)
+		assert.Equal(t, "sentry_test.go", f.Filename)
+		assert.Equal(t, 17, f.Lineno)
+		assert.True(t, f.InApp)
+
+		assert.Equal(t, "*errors.ErrAttrs", values[2].Type)
+		assert.Equal(t, "query failed", values[2].Value)
+		assert.Equal(t, 18, values[2].Stacktrace.Frames[0].Lineno)
+
+		assert.Equal(t, map[string]string{errors.OtelUserID: "user-1", errors.OtelErrorType: "not_found"}, e.Tags)
+		assert.Equal(t, map[string]any{"table": "users", "timeout": "1s"}, e.Extra)
+	})
+
+	t.Run("Stack", func(t *testing.T) {
+		err := errors.With().WithStack().Error("error")
+		e := sentry.NewEvent(err, nil)
+		require.Len(t, e.Exception.Values, 1)
+		frames := e.Exception.Values[0].Stacktrace.Frames
+		require.True(t, len(frames) > 1)
+		last := frames[len(frames)-1]
+		assert.Equal(t, "TestNewEvent.func2", last.Function)
+		assert.False(t, frames[0].InApp, frames[0].Module)
+	})
+
+	t.Run("Join", func(t *testing.T) {
+		err := errors.Join(readUser("user-1"), errors.Error("error"))
+		e := sentry.NewEvent(err, nil)
+		require.Len(t, e.Exception.Values, 4)
+		assert.Equal(t, "EOF", e.Exception.Values[0].Value)
+		assert.Equal(t, "error", e.Exception.Values[3].Value)
+	})
+
+	t.Run("RedactedMessage", func(t *testing.T) {
+		errors.SetRedactor(&errors.Redactor{Detectors: []errors.Detector{errors.DetectEmail}})
+		defer errors.SetRedactor(nil)
+
+		err := errors.Errorf("user 'user@example.com': %w", fmt.Errorf("contact admin@example.com"))
+		e := sentry.NewEvent(err, nil)
+		require.Len(t, e.Exception.Values, 2)
+		assert.Equal(t, "contact [REDACTED]", e.Exception.Values[0].Value)
+		assert.Equal(t, "user '[REDACTED]'", e.Exception.Values[1].Value)
+
+		e = sentry.NewEvent(fmt.Errorf("user 'user@example.com'"), nil)
+		assert.Equal(t, "user '[REDACTED]'", e.Exception.Values[0].Value)
+	})
+
+	t.Run("StdError", func(t *testing.T) {
+		e := sentry.NewEvent(fmt.Errorf("read: %w", io.EOF), nil)
+		assert.Equal(t, []sentry.Exception{{Type: "*fmt.wrapError", Value: "read: EOF"}}, e.Exception.Values)
+		assert.Nil(t, e.Tags)
+		assert.Nil(t, e.Extra)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.Equal(t, sentry.Event{}, sentry.NewEvent(nil, opts))
+	})
+}
@@ -0,0 +1,98 @@
+package sentry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kapetan-io/errors"
+)
+
+// Transport sends events to Sentry or a Sentry protocol compatible collector
+type Transport interface {
+	Send(ctx context.Context, e *Event) error
+}
+
+// HTTPTransport sends events to the envelope endpoint of the project identified by a DSN
+type HTTPTransport struct {
+	// Client is the http client used to send events, http.DefaultClient if nil
+	Client *http.Client
+	dsn    string
+	url    string
+	key    string
+}
+
+// NewHTTPTransport returns an HTTPTransport for the DSN of a Sentry project which is
+// in the form 'https://<public_key>@<host>/<project_id>'
+func NewHTTPTransport(dsn string) (*HTTPTransport, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, errors.Errorf("invalid DSN: %w", err)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, errors.With("dsn", dsn).Error("invalid DSN; missing public key")
+	}
+	path := strings.TrimSuffix(u.Path, "/")
+	i := strings.LastIndex(path, "/")
+	if i == -1 || path[i+1:] == "" {
+		return nil, errors.With("dsn", dsn).Error("invalid DSN; missing project id")
+	}
+
+	return &HTTPTransport{
+		dsn: dsn,
+		url: fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, path[:i], path[i+1:]),
+		key: u.User.Username(),
+	}, nil
+}
+
+// Send sends the event as a Sentry envelope
+func (t *HTTPTransport) Send(ctx context.Context, e *Event) error {
+	event, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	header, err := json.Marshal(map[string]string{
+		"event_id": e.EventID,
+		"dsn":      t.dsn,
+		"sent_at":  time.Now().UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	body.Write(header)
+	fmt.Fprintf(&body, "\n{\"type\":\"event\",\"length\":%d}\n", len(event))
+	body.Write(event)
+	body.WriteString("\n")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=7, sentry_client=kapetan-io-errors/1.0, sentry_key=%s", t.key))
+
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.With(errors.OtelHTTPResponseStatusCode, resp.StatusCode).
+			Errorf("sentry responded with '%s'", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package sentry_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/kapetan-io/errors/sentry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPTransport(t *testing.T) {
+	var (
+		auth   string
+		path   string
+		header map[string]string
+		item   map[string]any
+		event  sentry.Event
+	)
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		auth = r.Header.Get("X-Sentry-Auth")
+		s := bufio.NewScanner(r.Body)
+		for i := 0; s.Scan(); i++ {
+			switch i {
+			case 0:
+				require.NoError(t, json.Unmarshal(s.Bytes(), &header))
+			case 1:
+				require.NoError(t, json.Unmarshal(s.Bytes(), &item))
+			case 2:
+				require.NoError(t, json.Unmarshal(s.Bytes(), &event))
+			}
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	dsn := strings.Replace(srv.URL, "http://", "http://public-key@", 1) + "/42"
+	tr, err := sentry.NewHTTPTransport(dsn)
+	require.NoError(t, err)
+
+	err = readUser("user-1")
+	require.NoError(t, sentry.Send(context.Background(), tr, err, nil))
+	assert.Equal(t, "/api/42/envelope/", path)
+	assert.Contains(t, auth, "sentry_key=public-key")
+	assert.Equal(t, dsn, header["dsn"])
+	assert.Equal(t, "event", item["type"])
+	assert.Equal(t, header["event_id"], event.EventID)
+	assert.Equal(t, []string{errors.Fingerprint(err)}, event.Fingerprint)
+	require.Len(t, event.Exception.Values, 3)
+	assert.Equal(t, "query failed", event.Exception.Values[2].Value)
+
+	path = ""
+	require.NoError(t, sentry.Send(context.Background(), tr, nil, nil))
+	assert.Empty(t, path)
+
+	status = http.StatusTooManyRequests
+	err = sentry.Send(context.Background(), tr, io.EOF, nil)
+	require.Error(t, err)
+	assert.Equal(t, "sentry responded with '429 Too Many Requests'", err.Error())
+	assert.Equal(t, int64(429), errors.AttrsFrom(err)[0].Value.Int64())
+}
+
+func TestNewHTTPTransport(t *testing.T) {
+	for _, tt := range []struct {
+		dsn string
+		err string
+	}{
+		{dsn: "https://sentry.example.com/42", err: "invalid DSN; missing public key"},
+		{dsn: "https://key@sentry.example.com", err: "invalid DSN; missing project id"},
+		{dsn: "://", err: "invalid DSN: parse \"://\": missing protocol scheme"},
+	} {
+		t.Run(tt.dsn, func(t *testing.T) {
+			_, err := sentry.NewHTTPTransport(tt.dsn)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package errors
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+)
+
+// SpanStatusCode mirrors the OpenTelemetry `codes.Code` used with Span.SetStatus()
+type SpanStatusCode uint32
+
+const (
+	SpanStatusUnset SpanStatusCode = 0
+	SpanStatusError SpanStatusCode = 1
+	SpanStatusOk    SpanStatusCode = 2
+)
+
+// SpanAttr is an attribute recorded on a Span. Value is always one of the types
+// supported by OpenTelemetry attributes; string, bool, int64, float64, []string,
+// []bool, []int64 or []float64
+type SpanAttr struct {
+	Key   string
+	Value any
+}
+
+// Span mirrors the shape of the OpenTelemetry `trace.Span` using plain types such
+// that errors can be recorded on spans without depending upon the OpenTelemetry
+// libraries. See the `otelspan` module for an adapter to `trace.Span`.
+type Span interface {
+	// AddEvent adds an event with the provided name and attributes to the span
+	AddEvent(name string, attrs ...SpanAttr)
+	// SetAttributes sets the attributes on the span
+	SetAttributes(attrs ...SpanAttr)
+	// SetStatus sets the status of the span
+	SetStatus(code SpanStatusCode, description string)
+}
+
+// RecordOn records err on the span as an OpenTelemetry 'exception' event, in the
+// same way `trace.Span.RecordError()` does. The event includes the 'exception.type',
+// 'exception.message' and 'exception.stacktrace' attributes along with every attribute
+// and the code location from the err tree. The status of the span is set to error and
+// the 'error.type' attribute is set on the span if the error has a Kind. The message
+// and attributes are redacted by the Redactor set by SetRedactor().
+//
+//	if err != nil {
+//		errors.RecordOn(otelspan.New(span), err)
+//		return err
+//	}
+func RecordOn(span Span, err error) {
+	if err == nil {
+		return
+	}
+
+	msg := RedactMessage(err.Error())
+	attrs := []SpanAttr{
+		{Key: OtelExceptionType, Value: exceptionType(err)},
+		{Key: OtelExceptionMessage, Value: msg},
+	}
+	for _, a := range AttrsFromWithCodeLoc(err) {
+		if a.Key == "" {
+			continue
+		}
+		// Prefer the exception type and message recorded by Recover()
+		if a.Key == OtelExceptionType || a.Key == OtelExceptionMessage {
+			attrs = setSpanAttr(attrs, toSpanAttrs("", a))
+			continue
+		}
+		attrs = append(attrs, toSpanAttrs("", a)...)
+	}
+
+	span.AddEvent("exception", attrs...)
+	if k := KindOf(err); k != "" {
+		span.SetAttributes(SpanAttr{Key: OtelErrorType, Value: string(k)})
+	}
+	span.SetStatus(SpanStatusError, msg)
+}
+
+// exceptionType returns the type of the error closest to the root of the err tree
+func exceptionType(err error) string {
+	for {
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			if u := x.Unwrap(); u != nil {
+				err = u
+				continue
+			}
+		case interface{ Unwrap() []error }:
+			if errs := x.Unwrap(); len(errs) != 0 && errs[0] != nil {
+				err = errs[0]
+				continue
+			}
+		}
+		return fmt.Sprintf("%T", err)
+	}
+}
+
+func setSpanAttr(attrs []SpanAttr, set []SpanAttr) []SpanAttr {
+	for _, s := range set {
+		for i := range attrs {
+			if attrs[i].Key == s.Key {
+				attrs[i] = s
+			}
+		}
+	}
+	return attrs
+}
+
+// toSpanAttrs converts the slog.Attr into one or more SpanAttr, groups
+// are flattened using the group name as a prefix of the key.
+func toSpanAttrs(prefix string, a slog.Attr) []SpanAttr {
+	key := a.Key
+	if prefix != "" {
+		key = prefix + "." + a.Key
+	}
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindGroup:
+		var result []SpanAttr
+		for _, ga := range v.Group() {
+			result = append(result, toSpanAttrs(key, ga)...)
+		}
+		return result
+	case slog.KindString:
+		return []SpanAttr{{Key: key, Value: v.String()}}
+	case slog.KindInt64:
+		return []SpanAttr{{Key: key, Value: v.Int64()}}
+	case slog.KindUint64:
+		if v.Uint64() > math.MaxInt64 {
+			return []SpanAttr{{Key: key, Value: v.String()}}
+		}
+		return []SpanAttr{{Key: key, Value: int64(v.Uint64())}}
+	case slog.KindFloat64:
+		return []SpanAttr{{Key: key, Value: v.Float64()}}
+	case slog.KindBool:
+		return []SpanAttr{{Key: key, Value: v.Bool()}}
+	case slog.KindDuration:
+		return []SpanAttr{{Key: key, Value: v.Duration().String()}}
+	case slog.KindTime:
+		return []SpanAttr{{Key: key, Value: v.Time().Format(time.RFC3339Nano)}}
+	}
+	return []SpanAttr{{Key: key, Value: anyToSpanValue(v.Any())}}
+}
+
+func anyToSpanValue(v any) any {
+	switch x := v.(type) {
+	case string, bool, int64, float64, []string, []bool, []int64, []float64:
+		return x
+	case int:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case float32:
+		return float64(x)
+	case []int:
+		r := make([]int64, len(x))
+		for i := range x {
+			r[i] = int64(x[i])
+		}
+		return r
+	case error:
+		return x.Error()
+	case fmt.Stringer:
+		return x.String()
+	}
+	return fmt.Sprintf("%+v", v)
+}
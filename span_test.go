@@ -0,0 +1,138 @@
+package errors_test
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	name  string
+	attrs []errors.SpanAttr
+}
+
+type testSpan struct {
+	events []testEvent
+	attrs  []errors.SpanAttr
+	code   errors.SpanStatusCode
+	desc   string
+}
+
+func (s *testSpan) AddEvent(name string, attrs ...errors.SpanAttr) {
+	s.events = append(s.events, testEvent{name: name, attrs: attrs})
+}
+
+func (s *testSpan) SetAttributes(attrs ...errors.SpanAttr) {
+	s.attrs = append(s.attrs, attrs...)
+}
+
+func (s *testSpan) SetStatus(code errors.SpanStatusCode, desc string) {
+	s.code = code
+	s.desc = desc
+}
+
+func spanValue(attrs []errors.SpanAttr, key string) any {
+	for _, a := range attrs {
+		if a.Key == key {
+			return a.Value
+		}
+	}
+	return nil
+}
+
+func TestRecordOn(t *testing.T) {
+	t.Run("Attrs", func(t *testing.T) {
+		err := errors.With(
+			"str", "value",
+			"int", 1,
+			"uint", uint64(2),
+			"float", 1.5,
+			"bool", true,
+			"dur", time.Second,
+			"ints", []int{1, 2},
+			"cause", io.EOF,
+			slog.Group("req", "method", "GET"),
+		).WithKind(errors.NotFound).Errorf("while reading: %w", io.EOF)
+
+		var span testSpan
+		errors.RecordOn(&span, err)
+
+		require.Len(t, span.events, 1)
+		assert.Equal(t, "exception", span.events[0].name)
+		attrs := span.events[0].attrs
+		assert.Equal(t, "*errors.errorString", spanValue(attrs, errors.OtelExceptionType))
+		assert.Equal(t, "while reading: EOF", spanValue(attrs, errors.OtelExceptionMessage))
+		assert.Equal(t, "value", spanValue(attrs, "str"))
+		assert.Equal(t, int64(1), spanValue(attrs, "int"))
+		assert.Equal(t, int64(2), spanValue(attrs, "uint"))
+		assert.Equal(t, 1.5, spanValue(attrs, "float"))
+		assert.Equal(t, true, spanValue(attrs, "bool"))
+		assert.Equal(t, "1s", spanValue(attrs, "dur"))
+		assert.Equal(t, []int64{1, 2}, spanValue(attrs, "ints"))
+		assert.Equal(t, "EOF", spanValue(attrs, "cause"))
+		assert.Equal(t, "GET", spanValue(attrs, "req.method"))
+		assert.Equal(t, "github.com/kapetan-io/errors_test.TestRecordOn.func1", spanValue(attrs, errors.OtelCodeFunction))
+
+		assert.Equal(t, []errors.SpanAttr{{Key: errors.OtelErrorType, Value: "not_found"}}, span.attrs)
+		assert.Equal(t, errors.SpanStatusError, span.code)
+		assert.Equal(t, "while reading: EOF", span.desc)
+	})
+
+	t.Run("Stack", func(t *testing.T) {
+		err := errors.With().WithStack().Error("error")
+
+		var span testSpan
+		errors.RecordOn(&span, err)
+		require.Len(t, span.events, 1)
+		st, ok := spanValue(span.events[0].attrs, errors.OtelExceptionStacktrace).(string)
+		require.True(t, ok)
+		assert.Contains(t, st, "github.com/kapetan-io/errors_test.TestRecordOn")
+		assert.Empty(t, span.attrs)
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		var span testSpan
+		errors.RecordOn(&span, panicWith(42))
+		require.Len(t, span.events, 1)
+		attrs := span.events[0].attrs
+		assert.Equal(t, "int", spanValue(attrs, errors.OtelExceptionType))
+		assert.Equal(t, "42", spanValue(attrs, errors.OtelExceptionMessage))
+		assert.Equal(t, int64(42), spanValue(attrs, "panic.value"))
+	})
+
+	t.Run("StdError", func(t *testing.T) {
+		var span testSpan
+		errors.RecordOn(&span, io.EOF)
+		require.Len(t, span.events, 1)
+		assert.Equal(t, []errors.SpanAttr{
+			{Key: errors.OtelExceptionType, Value: "*errors.errorString"},
+			{Key: errors.OtelExceptionMessage, Value: "EOF"},
+		}, span.events[0].attrs)
+		assert.Equal(t, errors.SpanStatusError, span.code)
+	})
+
+	t.Run("Redacted", func(t *testing.T) {
+		errors.SetRedactor(&errors.Redactor{Detectors: []errors.Detector{errors.DetectEmail}})
+		defer errors.SetRedactor(nil)
+
+		var span testSpan
+		errors.RecordOn(&span, errors.With("email", "user@example.com").Error("user 'user@example.com' not found"))
+		require.Len(t, span.events, 1)
+		attrs := span.events[0].attrs
+		assert.Equal(t, "user '[REDACTED]' not found", spanValue(attrs, errors.OtelExceptionMessage))
+		assert.Equal(t, "[REDACTED]", spanValue(attrs, "email"))
+		assert.Equal(t, "user '[REDACTED]' not found", span.desc)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		var span testSpan
+		errors.RecordOn(&span, nil)
+		assert.Empty(t, span.events)
+		assert.Equal(t, errors.SpanStatusUnset, span.code)
+	})
+}
@@ -0,0 +1,100 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// maxStackDepth is the maximum number of frames captured when stack capture is enabled
+const maxStackDepth = 32
+
+// HasStack is used to identify errors which captured the full call stack at the code
+// location where the error was created. It is the responsibility of Stack() implementation
+// to unwrap the error tree and return the stack closest to the root of the err tree.
+type HasStack interface {
+	Stack() []uintptr
+	Error() string
+}
+
+var captureStack atomic.Bool
+
+// SetCaptureStack enables or disables capturing the full call stack for all errors
+// created by this package. When disabled (the default) only the code location where
+// the error was created is captured, unless Attrs.WithStack() is used.
+//
+//	errors.SetCaptureStack(true)
+//	err := errors.Error("query failed")
+//
+//	// Prints `query failed ()` followed by the call stack
+//	fmt.Printf("%+v\n", err)
+func SetCaptureStack(enabled bool) {
+	captureStack.Store(enabled)
+}
+
+// callers returns the call stack of the caller of the function which called callers()
+// or nil if stack capture is not enabled globally or for this *Attrs.
+func (a *Attrs) callers() []uintptr {
+	if !a.stack && !captureStack.Load() {
+		return nil
+	}
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(3, pcs) // skip [runtime.Callers, callers, and the calling function]
+	return pcs[:n]
+}
+
+// formatStack returns the stack in the same format as a go panic
+func formatStack(frames []runtime.Frame) string {
+	var buf strings.Builder
+	for i, f := range frames {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		_, _ = fmt.Fprintf(&buf, "%s\n\t%s:%d", f.Function, f.File, f.Line)
+	}
+	return buf.String()
+}
+
+func framesFromPCs(pcs []uintptr) []runtime.Frame {
+	if len(pcs) == 0 {
+		return nil
+	}
+	var result []runtime.Frame
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		result = append(result, f)
+		if !more {
+			break
+		}
+	}
+	return result
+}
+
+// framesFromStack returns the stack frames of s, which includes
+// frames decoded by UnmarshalJSON() if s is an ErrAttrs
+func framesFromStack(s HasStack) []runtime.Frame {
+	if e, ok := s.(*ErrAttrs); ok {
+		return e.stackFrames()
+	}
+	return framesFromPCs(s.Stack())
+}
+
+// stackFrom returns the frames of the stack closest to the root of the err tree
+func stackFrom(err error) []runtime.Frame {
+	var s HasStack
+	if errors.As(err, &s) {
+		return framesFromStack(s)
+	}
+	return nil
+}
+
+func attrsFromStack(err error) []slog.Attr {
+	if frames := stackFrom(err); len(frames) != 0 {
+		return []slog.Attr{slog.String(OtelExceptionStacktrace, formatStack(frames))}
+	}
+	return nil
+}
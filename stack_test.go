@@ -0,0 +1,84 @@
+package errors_test
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/kapetan-io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithStack(t *testing.T) {
+	err := errors.With("foo", "bar").WithStack().Error("query failed")
+
+	var s errors.HasStack
+	require.True(t, errors.As(err, &s))
+	require.NotEmpty(t, s.Stack())
+
+	t.Run("AttrsFromWithCodeLoc", func(t *testing.T) {
+		st := findAttr(errors.AttrsFromWithCodeLoc(err), errors.OtelExceptionStacktrace)
+		require.NotNil(t, st)
+		assert.Contains(t, st.Value.String(), "github.com/kapetan-io/errors_test.TestWithStack")
+		assert.Contains(t, st.Value.String(), "stack_test.go")
+		assert.Contains(t, st.Value.String(), "testing.tRunner")
+	})
+
+	t.Run("AttrsFromAll", func(t *testing.T) {
+		assert.NotNil(t, findAttr(errors.AttrsFromAll(err), errors.OtelExceptionStacktrace))
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		out := fmt.Sprintf("%+v", err)
+		assert.True(t, strings.HasPrefix(out, "query failed (foo=bar)\ngithub.com/kapetan-io/errors_test.TestWithStack\n\t"))
+		assert.Equal(t, "query failed", fmt.Sprintf("%v", err))
+	})
+
+	t.Run("WrappedStackClosestToRoot", func(t *testing.T) {
+		wrap := errors.Errorf("wrap: %w", err)
+		var s errors.HasStack
+		require.True(t, errors.As(wrap, &s))
+		assert.Equal(t, err.(errors.HasStack).Stack(), s.Stack())
+		// Stack is only written once
+		assert.Equal(t, 1, strings.Count(fmt.Sprintf("%+v", errors.Wrap(err)), "TestWithStack"))
+	})
+
+	t.Run("DoesNotModifyParent", func(t *testing.T) {
+		attrs := errors.With("foo", "bar")
+		_ = attrs.WithStack()
+		assert.Nil(t, attrs.Error("no stack").(errors.HasStack).Stack())
+	})
+}
+
+func TestSetCaptureStack(t *testing.T) {
+	errors.SetCaptureStack(true)
+	defer errors.SetCaptureStack(false)
+
+	for _, err := range []error{
+		errors.Error("error"),
+		errors.Errorf("errorf"),
+		errors.Wrap(errors.New("wrap")),
+		errors.With("key", "value").Error("error"),
+	} {
+		var s errors.HasStack
+		require.True(t, errors.As(err, &s))
+		f := findAttr(errors.AttrsFromAll(err), errors.OtelExceptionStacktrace)
+		require.NotNil(t, f, err.Error())
+		assert.True(t, strings.HasPrefix(f.Value.String(),
+			"github.com/kapetan-io/errors_test.TestSetCaptureStack\n"), f.Value.String())
+	}
+
+	errors.SetCaptureStack(false)
+	assert.Nil(t, findAttr(errors.AttrsFromAll(errors.Error("error")), errors.OtelExceptionStacktrace))
+}
+
+func findAttr(attrs []slog.Attr, key string) *slog.Attr {
+	for _, a := range attrs {
+		if a.Key == key {
+			return &a
+		}
+	}
+	return nil
+}